@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/gowtham2003/gotable/pkg/parser"
@@ -19,6 +20,52 @@ func main() {
 	outputFormat := flag.String("of", "", "Output format (auto-detect by default)")
 	style := flag.String("style", "single", "Table style (single, double, rounded)")
 	noHeader := flag.Bool("no-header", false, "Treat first row as data")
+	table := flag.String("table", "", "Table to extract, by index, HTML CSS selector or SQL table name")
+	tableCaption := flag.String("table-caption", "", "HTML table to extract, by caption text")
+	allTables := flag.Bool("all-tables", false, "Extract every table in the input")
+	listTables := flag.Bool("list-tables", false, "List the tables in the input and exit")
+	path := flag.String("path", "", "Path of the records inside XML (/catalog/book) or JSON, YAML or TOML ($.data.items) input")
+	keyColumn := flag.String("key-column", "key", "Column holding the keys of a JSON object of objects")
+	xmlRoot := flag.String("xml-root", "", "Document element name for XML output (default \"rows\")")
+	xmlRecord := flag.String("xml-record", "", "Record element name for XML output (default \"row\")")
+	xmlAttrs := flag.String("xml-attrs", "", "Comma separated columns written as XML attributes")
+	jsonHeaderRow := flag.Bool("json-header-row", false, "Read and write a leading JSON object holding the headers")
+	arrays := flag.String("arrays", "index", "How JSON arrays become columns (index, join, json)")
+	explode := flag.String("explode", "", "JSON array field whose items each become a row")
+	unflatten := flag.Bool("unflatten", false, "Nest dotted headers into objects in JSON, YAML or TOML output")
+	skipInvalid := flag.Bool("skip-invalid", false, "Skip JSON Lines records that fail to parse")
+	emphasis := flag.Bool("emphasis", false, "Keep bold, italic and code formatting from HTML cells")
+	delimiter := flag.String("delimiter", "", "CSV field delimiter (auto-detect by default)")
+	quote := flag.String("quote", "", "CSV quote character (default '\"')")
+	comment := flag.String("comment", "", "Skip CSV lines starting with this character")
+	lazyQuotes := flag.Bool("lazy-quotes", false, "Allow stray quotes inside CSV fields")
+	trimSpace := flag.Bool("trim-space", false, "Trim leading spaces from CSV fields")
+	outDelimiter := flag.String("out-delimiter", "", "CSV output field delimiter (default \",\")")
+	alwaysQuote := flag.Bool("always-quote", false, "Quote every field in CSV output")
+	crlf := flag.Bool("crlf", false, "End CSV output lines with CRLF")
+	tooMany := flag.String("too-many", "extra", "CSV records with surplus fields (extra, pad, truncate, error)")
+	tooFew := flag.String("too-few", "pad", "CSV records with missing fields (pad, error)")
+	strict := flag.Bool("strict", false, "Fail on malformed CSV records instead of repairing them")
+	columns := flag.String("columns", "", "Fixed-width input columns, e.g. id:1-6,name:7-30 or id:6,name:24 (inferred by default)")
+	widths := flag.String("widths", "", "Comma separated column widths for fixed-width output")
+	outNoHeader := flag.Bool("out-no-header", false, "Leave the header out of fixed-width or AsciiDoc output")
+	encoding := flag.String("encoding", "", "Input character encoding, e.g. windows-1252 (auto-detect by default)")
+	outEncoding := flag.String("out-encoding", "", "Output character encoding, e.g. utf-8-bom or utf-16 (default \"utf-8\")")
+	query := flag.String("query", "", "SQL query whose result is read from SQLite input")
+	outTable := flag.String("out-table", "", "Table name for SQL and SQLite output (default: the input table name or \"data\")")
+	dialect := flag.String("dialect", "postgres", "SQL output dialect (postgres, mysql, sqlite, sqlserver)")
+	noCreate := flag.Bool("no-create", false, "Leave the CREATE TABLE statement out of SQL output")
+	batchSize := flag.Int("batch-size", 0, "Rows per INSERT statement in SQL and SQLite output, or per Arrow record batch")
+	ifExists := flag.String("if-exists", "fail", "When the SQLite output table exists: fail, replace or append")
+	booktabs := flag.Bool("booktabs", false, "Draw LaTeX output rules with the booktabs package")
+	longtable := flag.Bool("longtable", false, "Write LaTeX output as a longtable that breaks across pages")
+	caption := flag.String("caption", "", "Caption of LaTeX, reStructuredText, AsciiDoc, Org or MediaWiki output")
+	label := flag.String("label", "", "Label of LaTeX, reStructuredText, AsciiDoc or Org output, e.g. tab:results")
+	templateFile := flag.String("template", "", "Go template file to render output with (text/template, or html/template for .html files)")
+	mdPad := flag.Bool("md-pad", false, "Pad Markdown output columns to the same width")
+	mdCompact := flag.Bool("md-compact", false, "Write Markdown output without spaces around cells")
+	rstSimple := flag.Bool("rst-simple", false, "Write reStructuredText output as a simple table instead of a grid table")
+	compression := flag.String("compression", "", "Parquet (default snappy) or Arrow (default none) output compression")
 	help := flag.Bool("help", false, "Show help message")
 	flag.Parse()
 
@@ -30,24 +77,72 @@ func main() {
 	if *cliMode {
 		// Get input and output files from remaining arguments
 		args := flag.Args()
-		if len(args) < 2 {
+		if len(args) < 2 && !(*listTables && len(args) == 1) {
 			fmt.Println("Error: Input and output files are required")
 			showHelp()
 			os.Exit(1)
 		}
 
-		inputFile := args[0]
-		outputFile := args[1]
-
-		// Run CLI mode conversion
-		if err := runCLIMode(cliOptions{
-			inputFile:    inputFile,
-			outputFile:   outputFile,
+		opts := cliOptions{
+			inputFile:    args[0],
 			inputFormat:  *inputFormat,
 			outputFormat: *outputFormat,
 			style:        *style,
 			noHeader:     *noHeader,
-		}); err != nil {
+			table:        *table,
+			tableCaption: *tableCaption,
+			allTables:    *allTables,
+			emphasis:     *emphasis,
+			path:         *path,
+			keyColumn:    *keyColumn,
+			xmlRoot:      *xmlRoot,
+			xmlRecord:    *xmlRecord,
+			xmlAttrs:     *xmlAttrs,
+			jsonHeader:   *jsonHeaderRow,
+			arrays:       *arrays,
+			explode:      *explode,
+			unflatten:    *unflatten,
+			skipInvalid:  *skipInvalid,
+			delimiter:    *delimiter,
+			quote:        *quote,
+			comment:      *comment,
+			lazyQuotes:   *lazyQuotes,
+			trimSpace:    *trimSpace,
+			outDelimiter: *outDelimiter,
+			alwaysQuote:  *alwaysQuote,
+			crlf:         *crlf,
+			tooMany:      *tooMany,
+			tooFew:       *tooFew,
+			strict:       *strict,
+			columns:      *columns,
+			widths:       *widths,
+			outNoHeader:  *outNoHeader,
+			encoding:     *encoding,
+			outEncoding:  *outEncoding,
+			query:        *query,
+			outTable:     *outTable,
+			ifExists:     *ifExists,
+			dialect:      *dialect,
+			noCreate:     *noCreate,
+			batchSize:    *batchSize,
+			compression:  *compression,
+			booktabs:     *booktabs,
+			longtable:    *longtable,
+			caption:      *caption,
+			label:        *label,
+			rstSimple:    *rstSimple,
+			templateFile: *templateFile,
+			mdPad:        *mdPad,
+			mdCompact:    *mdCompact,
+		}
+
+		run := runCLIMode
+		if *listTables && len(args) == 1 {
+			run = runListTables
+		} else {
+			opts.outputFile = args[1]
+		}
+		if err := run(opts); err != nil {
 			fmt.Printf("Error: %v\n", err)
 			os.Exit(1)
 		}
@@ -68,41 +163,77 @@ type cliOptions struct {
 	outputFormat string
 	style        string
 	noHeader     bool
+	table        string
+	tableCaption string
+	allTables    bool
+	emphasis     bool
+	path         string
+	keyColumn    string
+	xmlRoot      string
+	xmlRecord    string
+	xmlAttrs     string
+	jsonHeader   bool
+	arrays       string
+	explode      string
+	unflatten    bool
+	skipInvalid  bool
+	delimiter    string
+	quote        string
+	comment      string
+	lazyQuotes   bool
+	trimSpace    bool
+	outDelimiter string
+	alwaysQuote  bool
+	crlf         bool
+	tooMany      string
+	tooFew       string
+	strict       bool
+	columns      string
+	widths       string
+	outNoHeader  bool
+	encoding     string
+	outEncoding  string
+	query        string
+	outTable     string
+	ifExists     string
+	dialect      string
+	noCreate     bool
+	batchSize    int
+	compression  string
+	booktabs     bool
+	longtable    bool
+	caption      string
+	label        string
+	rstSimple    bool
+	templateFile string
+	mdPad        bool
+	mdCompact    bool
 }
 
 func runCLIMode(opts cliOptions) error {
-	// Read input file
-	input, err := os.ReadFile(opts.inputFile)
+	p, input, err := openInput(&opts)
 	if err != nil {
-		return fmt.Errorf("failed to read input file: %v", err)
+		return err
 	}
 
-	// Auto-detect formats from file extensions if not specified
-	if opts.inputFormat == "" {
-		opts.inputFormat = detectFormat(opts.inputFile)
+	// Auto-detect the output format from the file extension if not specified
+	if opts.outputFormat == "" && opts.templateFile != "" {
+		opts.outputFormat = "template"
 	}
 	if opts.outputFormat == "" {
 		opts.outputFormat = detectFormat(opts.outputFile)
 	}
 
-	// Create parser
-	p, err := parser.NewParser(opts.inputFormat)
-	if err != nil {
-		return fmt.Errorf("failed to create parser: %v", err)
-	}
-
-	// Parse input
-	data, err := p.Parse(input)
-	if err != nil {
-		return fmt.Errorf("failed to parse input: %v", err)
-	}
-
 	// Create renderer
 	r, err := renderer.NewRenderer(opts.outputFormat)
 	if err != nil {
 		return fmt.Errorf("failed to create renderer: %v", err)
 	}
 
+	if err := configureRenderer(r, opts); err != nil {
+		return err
+	}
+
 	// Apply style if renderer supports it
 	if styler, ok := r.(renderer.Styleable); ok {
 		styler.SetStyle(renderer.StyleOptions{
@@ -111,14 +242,62 @@ func runCLIMode(opts cliOptions) error {
 		})
 	}
 
+	var tables []*parser.TableData
+	if opts.allTables {
+		multi, ok := p.(parser.MultiTableParser)
+		if !ok {
+			return fmt.Errorf("input format %s does not support extracting all tables", opts.inputFormat)
+		}
+		if tables, err = multi.ParseAll(input); err != nil {
+			return fmt.Errorf("failed to parse input: %v", err)
+		}
+	} else {
+		// Parse input
+		data, err := p.Parse(input)
+		if err != nil {
+			return fmt.Errorf("failed to parse input: %v", err)
+		}
+		tables = []*parser.TableData{data}
+	}
+	reportWarnings(tables...)
+
+	// Databases are written in place so existing tables can be kept
+	if fr, ok := r.(renderer.FileRenderer); ok {
+		if opts.outEncoding != "" {
+			return fmt.Errorf("output format %s does not take an encoding", opts.outputFormat)
+		}
+		if err := fr.RenderFile(opts.outputFile, tables); err != nil {
+			return fmt.Errorf("failed to render output: %v", err)
+		}
+		fmt.Printf("Successfully converted %s to %s\n", opts.inputFile, opts.outputFile)
+		return nil
+	}
+
 	// Render output
-	output, err := r.Render(data)
+	var output string
+	if opts.allTables {
+		output, err = renderAll(r, tables)
+	} else {
+		output, err = r.Render(tables[0])
+	}
 	if err != nil {
 		return fmt.Errorf("failed to render output: %v", err)
 	}
 
+	// Encode output
+	content := []byte(output)
+	if opts.outEncoding != "" {
+		switch r.(type) {
+		case *renderer.ExcelRenderer, *renderer.ImageRenderer, *renderer.ParquetRenderer, *renderer.ArrowRenderer:
+			return fmt.Errorf("output format %s does not take an encoding", opts.outputFormat)
+		}
+		if content, err = renderer.Encode(output, opts.outEncoding); err != nil {
+			return err
+		}
+	}
+
 	// Write output file
-	if err := os.WriteFile(opts.outputFile, []byte(output), 0644); err != nil {
+	if err := os.WriteFile(opts.outputFile, content, 0644); err != nil {
 		return fmt.Errorf("failed to write output file: %v", err)
 	}
 
@@ -126,19 +305,367 @@ func runCLIMode(opts cliOptions) error {
 	return nil
 }
 
+// openInput reads the input file and creates the parser for it, configured
+// from the command line options. The input format is detected from the
+// file extension if not specified.
+func openInput(opts *cliOptions) (parser.Parser, []byte, error) {
+	// Read input file
+	input, err := os.ReadFile(opts.inputFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read input file: %v", err)
+	}
+
+	if opts.inputFormat == "" {
+		opts.inputFormat = detectFormat(opts.inputFile)
+	}
+
+	// Create parser
+	p, err := parser.NewParser(opts.inputFormat)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create parser: %v", err)
+	}
+
+	if err := configureParser(p, *opts); err != nil {
+		return nil, nil, err
+	}
+
+	// Convert input in a named encoding; text parsers detect the rest
+	if opts.encoding != "" {
+		switch p.(type) {
+		case *parser.ExcelParser, *parser.SQLiteParser, *parser.ParquetParser, *parser.ArrowParser:
+			return nil, nil, fmt.Errorf("input format %s does not take an encoding", opts.inputFormat)
+		}
+		if input, err = parser.Decode(input, opts.encoding); err != nil {
+			return nil, nil, err
+		}
+	}
+	return p, input, nil
+}
+
+// configureParser applies the format-specific command line options
+func configureParser(p parser.Parser, opts cliOptions) error {
+	flatten, err := flattenOptions(opts)
+	if err != nil {
+		return err
+	}
+
+	switch p := p.(type) {
+	case *parser.HTMLParser:
+		if index, err := strconv.Atoi(opts.table); err == nil {
+			p.Index = index
+		} else {
+			p.Selector = opts.table
+		}
+		p.Caption = opts.tableCaption
+		p.Emphasis = opts.emphasis
+	case *parser.MarkdownParser:
+		if index, err := strconv.Atoi(opts.table); err == nil {
+			p.Index = index
+		} else if opts.table != "" {
+			return fmt.Errorf("invalid table index %q for Markdown input", opts.table)
+		}
+	case *parser.XMLParser:
+		p.RecordPath = opts.path
+	case *parser.SQLiteParser:
+		p.Table = opts.table
+		p.Query = opts.query
+	case *parser.SQLParser:
+		p.Table = opts.table
+	case *parser.FixedWidthParser:
+		if opts.columns != "" {
+			columns, err := parser.ParseColumnSpec(opts.columns)
+			if err != nil {
+				return err
+			}
+			p.Columns = columns
+		}
+	case *parser.JSONParser:
+		p.Path = opts.path
+		p.KeyColumn = opts.keyColumn
+		p.HeaderRow = opts.jsonHeader
+		p.FlattenOptions = flatten
+	case *parser.YAMLParser:
+		p.Path = opts.path
+		p.KeyColumn = opts.keyColumn
+		p.FlattenOptions = flatten
+	case *parser.TOMLParser:
+		p.Path = opts.path
+		p.KeyColumn = opts.keyColumn
+		p.FlattenOptions = flatten
+	case *parser.JSONLParser:
+		p.SkipInvalid = opts.skipInvalid
+		p.FlattenOptions = flatten
+	case *parser.CSVParser:
+		// Options left unset keep the parser's defaults, e.g. tabs for TSV
+		for _, opt := range []struct {
+			value  string
+			target *rune
+		}{{opts.delimiter, &p.Delimiter}, {opts.quote, &p.Quote}, {opts.comment, &p.Comment}} {
+			if opt.value == "" {
+				continue
+			}
+			c, err := parseRune(opt.value)
+			if err != nil {
+				return err
+			}
+			*opt.target = c
+		}
+		p.LazyQuotes = p.LazyQuotes || opts.lazyQuotes
+		p.TrimLeadingSpace = opts.trimSpace
+		p.TooMany = parser.FieldCountMode(opts.tooMany)
+		p.TooFew = parser.FieldCountMode(opts.tooFew)
+		p.Strict = opts.strict
+	}
+	return nil
+}
+
+func flattenOptions(opts cliOptions) (parser.FlattenOptions, error) {
+	arrays, err := parser.ParseArrayMode(opts.arrays)
+	if err != nil {
+		return parser.FlattenOptions{}, err
+	}
+	return parser.FlattenOptions{
+		Arrays:  arrays,
+		Explode: opts.explode,
+	}, nil
+}
+
+// configureRenderer applies the format-specific command line options
+func configureRenderer(r renderer.Renderer, opts cliOptions) error {
+	switch r := r.(type) {
+	case *renderer.XMLRenderer:
+		r.Root = opts.xmlRoot
+		r.Record = opts.xmlRecord
+		r.Attributes = splitList(opts.xmlAttrs)
+	case *renderer.JSONRenderer:
+		r.HeaderRow = opts.jsonHeader
+		r.Unflatten = opts.unflatten
+	case *renderer.JSONLRenderer:
+		r.Unflatten = opts.unflatten
+	case *renderer.SQLiteRenderer:
+		r.Table = opts.outTable
+		r.IfExists = renderer.ExistsMode(opts.ifExists)
+		r.BatchSize = opts.batchSize
+	case *renderer.SQLRenderer:
+		dialect, err := renderer.ParseSQLDialect(opts.dialect)
+		if err != nil {
+			return err
+		}
+		r.Dialect = dialect
+		r.Table = opts.outTable
+		r.CreateTable = !opts.noCreate
+		r.BatchSize = opts.batchSize
+	case *renderer.ParquetRenderer:
+		if _, err := renderer.ParseCompression(opts.compression); err != nil {
+			return err
+		}
+		r.Compression = opts.compression
+	case *renderer.ArrowRenderer:
+		r.Compression = opts.compression
+		r.BatchSize = opts.batchSize
+	case *renderer.MarkdownRenderer:
+		r.Pad = opts.mdPad
+		r.Compact = opts.mdCompact
+	case *renderer.LaTeXRenderer:
+		r.Booktabs = opts.booktabs
+		r.LongTable = opts.longtable
+		r.Caption = opts.caption
+		r.Label = opts.label
+	case *renderer.RSTRenderer:
+		r.Simple = opts.rstSimple
+		r.Caption = opts.caption
+		r.Label = opts.label
+	case *renderer.AsciiDocRenderer:
+		r.NoHeader = opts.outNoHeader
+		r.Caption = opts.caption
+		r.Label = opts.label
+	case *renderer.OrgRenderer:
+		r.Caption = opts.caption
+		r.Label = opts.label
+	case *renderer.MediaWikiRenderer:
+		r.Caption = opts.caption
+	case *renderer.TemplateRenderer:
+		if opts.templateFile == "" {
+			return fmt.Errorf("template output needs a -template file")
+		}
+		content, err := os.ReadFile(opts.templateFile)
+		if err != nil {
+			return fmt.Errorf("failed to read template: %v", err)
+		}
+		r.Template = string(content)
+		r.Name = filepath.Base(opts.templateFile)
+		// HTML templates escape values for the context they appear in
+		name := strings.ToLower(r.Name)
+		r.HTML = strings.Contains(name, ".htm") || strings.HasSuffix(name, ".gohtml")
+	case *renderer.YAMLRenderer:
+		r.Unflatten = opts.unflatten
+	case *renderer.TOMLRenderer:
+		r.Unflatten = opts.unflatten
+	case *renderer.CSVRenderer:
+		if opts.outDelimiter != "" {
+			c, err := parseRune(opts.outDelimiter)
+			if err != nil {
+				return err
+			}
+			r.Delimiter = c
+		}
+		r.AlwaysQuote = opts.alwaysQuote
+		r.CRLF = opts.crlf
+	case *renderer.FixedWidthRenderer:
+		for _, item := range splitList(opts.widths) {
+			width, err := strconv.Atoi(item)
+			if err != nil || width < 1 {
+				return fmt.Errorf("invalid column width %q", item)
+			}
+			r.Widths = append(r.Widths, width)
+		}
+		r.NoHeader = opts.outNoHeader
+	}
+	return nil
+}
+
+// parseRune reads a single character flag value, accepting names for the
+// characters that are awkward to type in a shell
+func parseRune(value string) (rune, error) {
+	switch strings.ToLower(value) {
+	case "tab", `\t`:
+		return '\t', nil
+	case "comma":
+		return ',', nil
+	case "semicolon":
+		return ';', nil
+	case "pipe":
+		return '|', nil
+	case "space":
+		return ' ', nil
+	}
+	runes := []rune(value)
+	if len(runes) != 1 {
+		return 0, fmt.Errorf("invalid character %q: expected a single character", value)
+	}
+	return runes[0], nil
+}
+
+// splitList splits a comma separated flag value, dropping empty entries
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// maxWarnings limits how many input warnings are printed
+const maxWarnings = 20
+
+// reportWarnings prints the problems the parser worked around to stderr
+func reportWarnings(tables ...*parser.TableData) {
+	var warnings []parser.Warning
+	for _, data := range tables {
+		warnings = append(warnings, data.Warnings...)
+	}
+	for i, w := range warnings {
+		if i == maxWarnings {
+			fmt.Fprintf(os.Stderr, "Warning: %d more problems not shown\n", len(warnings)-maxWarnings)
+			break
+		}
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", w)
+	}
+}
+
+// renderAll renders several tables, letting renderers that support it
+// combine them and separating the others with a blank line
+func renderAll(r renderer.Renderer, tables []*parser.TableData) (string, error) {
+	if multi, ok := r.(renderer.MultiRenderer); ok {
+		return multi.RenderAll(tables)
+	}
+
+	outputs := make([]string, len(tables))
+	for i, data := range tables {
+		output, err := r.Render(data)
+		if err != nil {
+			return "", err
+		}
+		outputs[i] = output
+	}
+	return strings.Join(outputs, "\n\n"), nil
+}
+
+// runListTables prints a short summary of every table in the input file
+func runListTables(opts cliOptions) error {
+	p, input, err := openInput(&opts)
+	if err != nil {
+		return err
+	}
+	multi, ok := p.(parser.MultiTableParser)
+	if !ok {
+		return fmt.Errorf("input format %s does not support listing tables", opts.inputFormat)
+	}
+
+	tables, err := multi.ParseAll(input)
+	if err != nil {
+		return fmt.Errorf("failed to parse input: %v", err)
+	}
+
+	for i, data := range tables {
+		caption := data.Name
+		if caption == "" {
+			caption = "-"
+		}
+		headers := data.Headers
+		if len(headers) > 5 {
+			headers = append(headers[:5:5], "...")
+		}
+		fmt.Printf("%3d  %-30s %4d rows x %2d cols  %s\n", i, caption, len(data.Rows), len(data.Headers), strings.Join(headers, ", "))
+	}
+	return nil
+}
+
 func detectFormat(filename string) string {
 	ext := strings.ToLower(filepath.Ext(filename))
 	switch ext {
 	case ".json":
 		return "json"
+	case ".jsonl", ".ndjson":
+		return "jsonl"
 	case ".csv":
 		return "csv"
+	case ".tsv", ".tab":
+		return "tsv"
 	case ".xlsx":
 		return "excel"
 	case ".html":
 		return "html"
 	case ".xml":
 		return "xml"
+	case ".db", ".sqlite", ".sqlite3":
+		return "sqlite"
+	case ".sql":
+		return "sql"
+	case ".parquet":
+		return "parquet"
+	case ".arrow", ".feather", ".ipc":
+		return "arrow"
+	case ".arrows":
+		return "arrows"
+	case ".yaml", ".yml":
+		return "yaml"
+	case ".toml":
+		return "toml"
+	case ".fwf":
+		return "fixed"
+	case ".tex":
+		return "latex"
+	case ".rst":
+		return "rst"
+	case ".adoc", ".asciidoc":
+		return "asciidoc"
+	case ".org":
+		return "org"
+	case ".wiki", ".mediawiki":
+		return "mediawiki"
 	case ".md":
 		return "markdown"
 	case ".txt":
@@ -163,11 +690,113 @@ Flags:
   -of string    Output format (auto-detect by default)
   -style string Table style (single, double, rounded) (default "single")
   -no-header    Treat first row as data
+  -table string Table to extract from HTML or Markdown by index, from HTML
+                by CSS selector (#id, .class) or from SQL and SQLite by name
+  -table-caption string
+                Table to extract from HTML, by caption text
+  -all-tables   Extract every table in the input
+  -list-tables  List the tables in the input file and exit
+  -json-header-row
+                Read and write a leading JSON object holding the headers
+  -arrays string
+                How JSON arrays become columns: index, join or json (default "index")
+  -explode string
+                JSON array field whose items each become a row
+  -unflatten    Nest dotted headers into objects in JSON, YAML or TOML output
+  -skip-invalid Skip JSON Lines records that fail to parse
+  -emphasis     Keep bold, italic and code formatting from HTML cells
+  -delimiter string
+                CSV field delimiter: a character or tab, comma, semicolon, pipe
+                (auto-detect by default)
+  -quote string CSV quote character (default '"')
+  -comment string
+                Skip CSV lines starting with this character
+  -lazy-quotes  Allow stray quotes inside CSV fields
+  -trim-space   Trim leading spaces from CSV fields
+  -out-delimiter string
+                CSV output field delimiter (default ",")
+  -always-quote Quote every field in CSV output
+  -crlf         End CSV output lines with CRLF
+  -too-many string
+                CSV records with surplus fields: extra (kept in an _extra
+                column), pad (columns added), truncate or error (default "extra")
+  -too-few string
+                CSV records with missing fields: pad or error (default "pad")
+  -strict       Fail on malformed CSV records instead of repairing them
+  -columns string
+                Fixed-width input columns as name:first-last (1-based) or
+                name:width, e.g. id:1-6,name:7-30 (inferred by default)
+  -widths string
+                Comma separated column widths for fixed-width output
+  -out-no-header
+                Leave the header out of fixed-width or AsciiDoc output
+  -encoding string
+                Input character encoding, e.g. windows-1252 or shift_jis.
+                BOMs, UTF-16 and UTF-32 are detected without it
+  -out-encoding string
+                Output character encoding: utf-8 (default), utf-8-bom,
+                utf-16 or a legacy code page such as windows-1252
+  -query string SQL query whose result is read from SQLite input
+  -out-table string
+                Table name for SQL and SQLite output (default: the input
+                table name or "data")
+  -dialect string
+                SQL output dialect: postgres, mysql, sqlite or sqlserver
+                (default "postgres")
+  -no-create    Leave the CREATE TABLE statement out of SQL output
+  -batch-size int
+                Rows per INSERT statement in SQL and SQLite output (default
+                100 and 500), or per Arrow record batch (default 65536)
+  -if-exists string
+                When the SQLite output table exists: fail, replace or append
+                (default "fail")
+  -compression string
+                Parquet output compression: snappy (default), gzip, zstd,
+                brotli, lz4 or none. Arrow output: none (default), lz4 or zstd
+  -booktabs     Draw LaTeX output rules with \toprule, \midrule and \bottomrule
+  -longtable    Write LaTeX output as a longtable that breaks across pages
+  -caption string
+                Caption of LaTeX, reStructuredText, AsciiDoc, Org or MediaWiki
+                output.
+                A LaTeX tabular with a caption is wrapped in a table float
+  -label string Label of LaTeX, reStructuredText, AsciiDoc or Org output,
+                e.g. tab:results
+  -template string
+                Go template file to render output with, implying -of template.
+                Files named .html, .htm or .gohtml use html/template
+  -md-pad       Pad Markdown output columns to the same width
+  -md-compact   Write Markdown output without spaces around cells
+  -rst-simple   Write reStructuredText output as a simple table instead of a
+                grid table
+  -path string  Path of the records inside XML (/catalog/book) or JSON, YAML
+                or TOML input ($.data.items[*] or .data.items)
+  -key-column string
+                Column holding the keys of a JSON object of objects (default "key")
+  -xml-root string
+                Document element name for XML output (default "rows")
+  -xml-record string
+                Record element name for XML output (default "row")
+  -xml-attrs string
+                Comma separated columns written as XML attributes
   -help         Show this help message
 
 Supported Formats:
-  Input:  json, csv, excel, html, xml
-  Output: ascii, html, excel, csv, json, markdown, png
+  Input:  json, jsonl, csv, tsv, excel, html, markdown, xml, ascii, fixed,
+          yaml, toml, sqlite, sql, parquet, arrow
+  Output: ascii, html, excel, csv, tsv, json, jsonl, markdown, png, xml, fixed,
+          yaml, toml, sqlite, sql, parquet, arrow, arrows (Arrow stream), latex,
+          rst, asciidoc, org, jira (Jira and Confluence wiki markup),
+          confluence (Confluence storage format), mediawiki, template
+
+Templates:
+  A -template file runs on the table: .Name, .Headers, .Rows (maps from
+  header to value), .Footer, .Types and .Align, with the methods
+  .Cells row (values in header order), .Index header, .Width header and
+  .ColumnType header. Helper functions: pad, padLeft and center (width
+  value), escape (format value, for html, xml, json, csv, sql, url,
+  markdown, latex, rst, asciidoc, org, jira, confluence or mediawiki),
+  upper, lower, trim, replace (old new value), join (sep values), repeat
+  (count value), add and json.
 
 Examples:
   # Convert JSON to ASCII table
@@ -179,6 +808,68 @@ Examples:
   # Convert Excel to Markdown without headers
   gotable -cli -no-header input.xlsx output.md
 
+  # Extract the table with id "prices" from a web page
+  gotable -cli -table "#prices" page.html prices.csv
+
+  # Convert the book elements of an XML catalog to CSV
+  gotable -cli -path /catalog/book catalog.xml books.csv
+
+  # Read the records wrapped in an API envelope
+  gotable -cli -path '$.data.items' response.json items.csv
+
+  # List the servers of a TOML config, or the records of a YAML stream
+  gotable -cli config.toml servers.csv
+  gotable -cli -of yaml events.jsonl events.yaml
+
+  # Flatten an API response, one row per order line
+  gotable -cli -explode lines orders.json lines.csv
+
+  # Convert a semicolon separated export to a tab separated file
+  gotable -cli -delimiter semicolon export.csv export.tsv
+
+  # Read a Windows-1252 export and write CSV that Excel opens as UTF-8
+  gotable -cli -encoding windows-1252 -out-encoding utf-8-bom export.csv clean.csv
+
+  # Convert the third table of a README to CSV
+  gotable -cli -table 2 README.md table.csv
+
+  # Turn a table pasted from psql or mysql into CSV
+  gotable -cli result.txt result.csv
+
+  # Read a mainframe export with a known record layout
+  gotable -cli -if fixed -columns acct:1-6,type:7-7,amount:8-14 ledger.dat ledger.csv
+
+  # Load a CSV export into a SQLite table, then query it back out
+  gotable -cli -out-table sales -if-exists append sales.csv analysis.db
+  gotable -cli -query 'SELECT region, sum(amount) AS total FROM sales GROUP BY region' analysis.db totals.md
+
+  # Write a MySQL seed script, or read the rows back from a dump
+  gotable -cli -dialect mysql -out-table users users.csv seed.sql
+  gotable -cli -table users dump.sql users.csv
+
+  # Preview a Parquet file as Markdown, or write CSV to zstd Parquet
+  gotable -cli events.parquet events.md
+  gotable -cli -compression zstd events.csv events.parquet
+
+  # Turn a Feather file from pandas or polars into an Excel sheet
+  gotable -cli frame.feather frame.xlsx
+
+  # Typeset a results table for a paper
+  gotable -cli -booktabs -caption "Benchmark results" -label tab:bench results.csv results.tex
+
+  # Paste a table into Sphinx docs, or into an Org notebook
+  gotable -cli -rst-simple results.csv results.rst
+  gotable -cli results.csv results.org
+
+  # Paste query results into a Jira ticket
+  gotable -cli -of jira results.csv results.txt
+
+  # Render rows with your own Go template
+  gotable -cli -template changelog.tmpl releases.json CHANGELOG.md
+
+  # Show which tables a page contains
+  gotable -cli -list-tables page.html
+
   # Convert with explicit formats
   gotable -cli -if json -of csv input.dat output.dat`)
 }
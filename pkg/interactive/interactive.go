@@ -83,7 +83,17 @@ func (im *InteractiveMode) getInputFormat(options *InteractiveOptions) error {
 	fmt.Println("2. CSV")
 	fmt.Println("3. Excel")
 	fmt.Println("4. HTML")
-	fmt.Print("Select input format (1-4): ")
+	fmt.Println("5. XML")
+	fmt.Println("6. Markdown")
+	fmt.Println("7. ASCII Table")
+	fmt.Println("8. Fixed-width")
+	fmt.Println("9. YAML")
+	fmt.Println("10. TOML")
+	fmt.Println("11. SQLite")
+	fmt.Println("12. SQL dump")
+	fmt.Println("13. Parquet")
+	fmt.Println("14. Arrow IPC / Feather")
+	fmt.Print("Select input format (1-14): ")
 
 	input, err := im.reader.ReadString('\n')
 	if err != nil {
@@ -99,6 +109,26 @@ func (im *InteractiveMode) getInputFormat(options *InteractiveOptions) error {
 		options.InputFormat = "xlsx"
 	case "4":
 		options.InputFormat = "html"
+	case "5":
+		options.InputFormat = "xml"
+	case "6":
+		options.InputFormat = "markdown"
+	case "7":
+		options.InputFormat = "ascii"
+	case "8":
+		options.InputFormat = "fixed"
+	case "9":
+		options.InputFormat = "yaml"
+	case "10":
+		options.InputFormat = "toml"
+	case "11":
+		options.InputFormat = "sqlite"
+	case "12":
+		options.InputFormat = "sql"
+	case "13":
+		options.InputFormat = "parquet"
+	case "14":
+		options.InputFormat = "arrow"
 	default:
 		return fmt.Errorf("invalid input format selection")
 	}
@@ -114,7 +144,22 @@ func (im *InteractiveMode) getOutputFormat(options *InteractiveOptions) error {
 	fmt.Println("5. JSON")
 	fmt.Println("6. Markdown")
 	fmt.Println("7. PNG Image")
-	fmt.Print("Select output format (1-7): ")
+	fmt.Println("8. XML")
+	fmt.Println("9. Fixed-width")
+	fmt.Println("10. YAML")
+	fmt.Println("11. TOML")
+	fmt.Println("12. SQLite")
+	fmt.Println("13. SQL script")
+	fmt.Println("14. Parquet")
+	fmt.Println("15. Arrow IPC / Feather")
+	fmt.Println("16. LaTeX")
+	fmt.Println("17. reStructuredText")
+	fmt.Println("18. AsciiDoc")
+	fmt.Println("19. Org mode")
+	fmt.Println("20. Jira / Confluence wiki markup")
+	fmt.Println("21. Confluence storage format")
+	fmt.Println("22. MediaWiki")
+	fmt.Print("Select output format (1-22): ")
 
 	input, err := im.reader.ReadString('\n')
 	if err != nil {
@@ -136,6 +181,36 @@ func (im *InteractiveMode) getOutputFormat(options *InteractiveOptions) error {
 		options.OutputFormat = "markdown"
 	case "7":
 		options.OutputFormat = "png"
+	case "8":
+		options.OutputFormat = "xml"
+	case "9":
+		options.OutputFormat = "fixed"
+	case "10":
+		options.OutputFormat = "yaml"
+	case "11":
+		options.OutputFormat = "toml"
+	case "12":
+		options.OutputFormat = "sqlite"
+	case "13":
+		options.OutputFormat = "sql"
+	case "14":
+		options.OutputFormat = "parquet"
+	case "15":
+		options.OutputFormat = "arrow"
+	case "16":
+		options.OutputFormat = "latex"
+	case "17":
+		options.OutputFormat = "rst"
+	case "18":
+		options.OutputFormat = "asciidoc"
+	case "19":
+		options.OutputFormat = "org"
+	case "20":
+		options.OutputFormat = "jira"
+	case "21":
+		options.OutputFormat = "confluence"
+	case "22":
+		options.OutputFormat = "mediawiki"
 	default:
 		return fmt.Errorf("invalid output format selection")
 	}
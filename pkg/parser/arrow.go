@@ -0,0 +1,127 @@
+package parser
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/arrio"
+)
+
+// arrowTable reads the record batches of an Arrow reader into a table, one
+// batch at a time. Integer, floating point, decimal, boolean, date, time and
+// timestamp columns keep their type; nested values are written as JSON and
+// nulls become missing cells.
+func arrowTable(schema *arrow.Schema, reader arrio.Reader) (*TableData, error) {
+	fields := schema.Fields()
+	names := make([]string, len(fields))
+	for i, field := range fields {
+		names[i] = field.Name
+	}
+	headers := uniqueHeaders(names)
+
+	types := newTypeTracker()
+	var rows []map[string]string
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		columns := record.Columns()
+		for i := 0; i < int(record.NumRows()); i++ {
+			row := make(map[string]string, len(headers))
+			for j, column := range columns {
+				value, kind, ok := arrowCell(column, i)
+				if !ok {
+					continue
+				}
+				row[headers[j]] = value
+				types.add(headers[j], kind)
+			}
+			rows = append(rows, row)
+		}
+	}
+	return &TableData{
+		Headers: headers,
+		Rows:    rows,
+		Types:   types.result(),
+	}, nil
+}
+
+// arrowCell formats a value of an Arrow array as cell text. It reports
+// false for null.
+func arrowCell(column arrow.Array, i int) (string, ColumnType, bool) {
+	if column.IsNull(i) || column.DataType().ID() == arrow.NULL {
+		return "", "", false
+	}
+
+	switch c := column.(type) {
+	case *array.Boolean:
+		return strconv.FormatBool(c.Value(i)), TypeBoolean, true
+	case *array.Int8, *array.Int16, *array.Int32, *array.Int64,
+		*array.Uint8, *array.Uint16, *array.Uint32, *array.Uint64:
+		return c.ValueStr(i), TypeInteger, true
+	case *array.Float16:
+		return cellValue(floatNumber(float64(c.Value(i).Float32())))
+	case *array.Float32:
+		return cellValue(floatNumber(float64(c.Value(i))))
+	case *array.Float64:
+		return cellValue(floatNumber(c.Value(i)))
+	case *array.Decimal128, *array.Decimal256:
+		return c.ValueStr(i), TypeFloat, true
+	case *array.String:
+		return c.Value(i), TypeString, true
+	case *array.LargeString:
+		return c.Value(i), TypeString, true
+	case *array.StringView:
+		return c.Value(i), TypeString, true
+	case *array.Binary:
+		return bytesCell(c.Value(i)), TypeString, true
+	case *array.LargeBinary:
+		return bytesCell(c.Value(i)), TypeString, true
+	case *array.FixedSizeBinary:
+		return bytesCell(c.Value(i)), TypeString, true
+	case *array.Date32:
+		return c.Value(i).ToTime().Format(time.DateOnly), TypeDate, true
+	case *array.Date64:
+		return c.Value(i).ToTime().Format(time.DateOnly), TypeDate, true
+	case *array.Timestamp:
+		kind := c.DataType().(*arrow.TimestampType)
+		t := c.Value(i).ToTime(kind.Unit)
+		if kind.TimeZone == "" {
+			// Timestamps without a zone are wall clock times
+			return t.Format("2006-01-02T15:04:05.999999999"), TypeTimestamp, true
+		}
+		return t.Format(time.RFC3339Nano), TypeTimestamp, true
+	case *array.Time32:
+		return c.Value(i).ToTime(c.DataType().(*arrow.Time32Type).Unit).Format("15:04:05.999999999"), TypeTime, true
+	case *array.Time64:
+		return c.Value(i).ToTime(c.DataType().(*arrow.Time64Type).Unit).Format("15:04:05.999999999"), TypeTime, true
+	case *array.Dictionary:
+		return arrowCell(c.Dictionary(), c.GetValueIndex(i))
+	}
+
+	// Lists, structs and maps are kept as compact JSON
+	encoded, err := json.Marshal(column.GetOneForMarshal(i))
+	if err != nil {
+		return column.ValueStr(i), TypeString, true
+	}
+	return string(encoded), TypeString, true
+}
+
+// bytesCell formats binary data as text, writing data that is not UTF-8 as
+// a SQL style hex literal
+func bytesCell(value []byte) string {
+	if utf8.Valid(value) {
+		return string(value)
+	}
+	return "x'" + hex.EncodeToString(value) + "'"
+}
@@ -0,0 +1,38 @@
+package parser
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+)
+
+// ArrowParser implements Parser for Apache Arrow IPC data, in either the
+// file format, which Feather version 2 also uses, or the stream format.
+// Record batches are read one at a time and columns take their type from
+// the Arrow schema.
+type ArrowParser struct{}
+
+var arrowMagic = []byte("ARROW1")
+
+func (p *ArrowParser) Parse(input []byte) (*TableData, error) {
+	if len(input) == 0 {
+		return nil, fmt.Errorf("empty Arrow input")
+	}
+
+	if bytes.HasPrefix(input, arrowMagic) {
+		reader, err := ipc.NewFileReader(bytes.NewReader(input))
+		if err != nil {
+			return nil, fmt.Errorf("invalid Arrow file: %v", err)
+		}
+		defer reader.Close()
+		return arrowTable(reader.Schema(), reader)
+	}
+
+	reader, err := ipc.NewReader(bytes.NewReader(input))
+	if err != nil {
+		return nil, fmt.Errorf("invalid Arrow stream: %v", err)
+	}
+	defer reader.Release()
+	return arrowTable(reader.Schema(), reader)
+}
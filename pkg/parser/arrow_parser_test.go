@@ -0,0 +1,99 @@
+package parser
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+)
+
+func TestArrowParser_Parse(t *testing.T) {
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "id", Type: arrow.PrimitiveTypes.Uint16},
+		{Name: "price", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+		{Name: "name", Type: arrow.BinaryTypes.LargeString},
+		{Name: "seen", Type: &arrow.TimestampType{Unit: arrow.Second}},
+		{Name: "flag", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
+		{Name: "nothing", Type: arrow.Null, Nullable: true},
+	}, nil)
+
+	record := func(ids []uint16, names []string) arrow.Record {
+		builder := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+		defer builder.Release()
+		builder.Field(0).(*array.Uint16Builder).AppendValues(ids, nil)
+		builder.Field(1).(*array.Float64Builder).AppendValues(make([]float64, len(ids)), []bool{true, false}[:len(ids)])
+		builder.Field(2).(*array.LargeStringBuilder).AppendValues(names, nil)
+		builder.Field(3).(*array.TimestampBuilder).AppendValues(make([]arrow.Timestamp, len(ids)), nil)
+		builder.Field(4).(*array.BooleanBuilder).AppendValues(make([]bool, len(ids)), []bool{false, true}[:len(ids)])
+		builder.Field(5).(*array.NullBuilder).AppendNulls(len(ids))
+		return builder.NewRecord()
+	}
+	batches := []arrow.Record{record([]uint16{1, 2}, []string{"a", "b"}), record([]uint16{3}, []string{"c"})}
+
+	want := &TableData{
+		Headers: []string{"id", "price", "name", "seen", "flag", "nothing"},
+		Rows: []map[string]string{
+			{"id": "1", "price": "0.0", "name": "a", "seen": "1970-01-01T00:00:00"},
+			{"id": "2", "name": "b", "seen": "1970-01-01T00:00:00", "flag": "false"},
+			{"id": "3", "price": "0.0", "name": "c", "seen": "1970-01-01T00:00:00"},
+		},
+		Types: map[string]ColumnType{"id": TypeInteger, "price": TypeFloat, "seen": TypeTimestamp, "flag": TypeBoolean},
+	}
+
+	tests := []struct {
+		name  string
+		write func(*bytes.Buffer) error
+	}{
+		{
+			name: "File",
+			write: func(buf *bytes.Buffer) error {
+				w, err := ipc.NewFileWriter(buf, ipc.WithSchema(schema), ipc.WithZstd())
+				if err != nil {
+					return err
+				}
+				for _, batch := range batches {
+					if err := w.Write(batch); err != nil {
+						return err
+					}
+				}
+				return w.Close()
+			},
+		},
+		{
+			name: "Stream",
+			write: func(buf *bytes.Buffer) error {
+				w := ipc.NewWriter(buf, ipc.WithSchema(schema), ipc.WithLZ4())
+				for _, batch := range batches {
+					if err := w.Write(batch); err != nil {
+						return err
+					}
+				}
+				return w.Close()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.write(&buf); err != nil {
+				t.Fatal(err)
+			}
+			got, err := (&ArrowParser{}).Parse(buf.Bytes())
+			if err != nil {
+				t.Fatalf("ArrowParser.Parse() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("ArrowParser.Parse() = %+v, want %+v", got, want)
+			}
+		})
+	}
+
+	if _, err := (&ArrowParser{}).Parse([]byte("not arrow")); err == nil {
+		t.Errorf("ArrowParser.Parse() of text should fail")
+	}
+}
@@ -0,0 +1,320 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ASCIIParser implements Parser for text tables drawn with ASCII or
+// box-drawing characters: gotable's own ASCII output, MySQL and psql client
+// output and Unicode tables. Column boundaries come from the junctions of
+// the separator lines. Text before and after the table, such as the query
+// or a "(3 rows)" footer, is ignored.
+type ASCIIParser struct{}
+
+const (
+	horizontalRunes = "-=:─━═┄┅┈┉"
+	verticalRunes   = "|│┃║┆┇┊┋"
+	heavyRunes      = "=═━"
+	junctionRunes   = "+┼┬┴├┤┌┐└┘╋┳┻┣┫┏┓┗┛╬╦╩╠╣╔╗╚╝╪╤╧╞╡╒╕╘╛╫╥╨╟╢╓╖╙╜╭╮╰╯┿╂"
+)
+
+// resultFooter matches the row counts clients print after a result set
+var resultFooter = regexp.MustCompile(`(?i)^\s*(\(\d+ rows?\)|\d+ rows? in set.*|empty set.*)\s*$`)
+
+// textLine is a line of the table, split into runes so positions line up
+// with the columns on screen
+type textLine struct {
+	runes  []rune
+	border bool
+}
+
+func (p *ASCIIParser) Parse(input []byte) (*TableData, error) {
+	input, err := Decode(input, "")
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(strings.ReplaceAll(string(input), "\r\n", "\n"), "\n")
+
+	table, err := findTextTable(lines)
+	if err != nil {
+		return nil, err
+	}
+
+	// The separator with the most junctions marks every column boundary
+	var boundaries []int
+	outer := false
+	for _, line := range table {
+		if !line.border {
+			continue
+		}
+		if b := junctions(line.runes); len(b) > len(boundaries) {
+			boundaries = b
+			first := []rune(strings.TrimLeft(string(line.runes), " "))
+			outer = len(first) > 0 && (strings.ContainsRune(junctionRunes, first[0]) || strings.ContainsRune(verticalRunes, first[0]))
+		}
+	}
+
+	// Split the table into runs of rows between separators; the first run
+	// is the header
+	var groups [][]textLine
+	var separators []string // the separator above each group
+	var current []textLine
+	separator := ""
+	for _, line := range table {
+		if !line.border {
+			if len(current) == 0 {
+				separators = append(separators, separator)
+			}
+			current = append(current, line)
+			continue
+		}
+		if len(current) > 0 {
+			groups = append(groups, current)
+			current = nil
+		}
+		separator = strings.TrimSpace(string(line.runes))
+	}
+	if len(current) > 0 {
+		groups = append(groups, current)
+	}
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("no rows found in text table")
+	}
+
+	split := func(line textLine) []string {
+		return splitTextRow(line.runes, boundaries, outer)
+	}
+
+	// Header cells wrapped over several lines are joined with spaces
+	var headers []string
+	for _, line := range groups[0] {
+		for i, cell := range split(line) {
+			if i >= len(headers) {
+				headers = append(headers, "")
+			}
+			headers[i] = strings.TrimSpace(headers[i] + " " + cell)
+		}
+	}
+	headers = uniqueHeaders(headers)
+	body := groups[1:]
+
+	// A light top border over a header rule of = is a grid table, where
+	// rules separate every row, as ASCIIRenderer draws tables with cells of
+	// several lines
+	top := ""
+	if table[0].border {
+		top = strings.TrimSpace(string(table[0].runes))
+	}
+	gridStyle := top != "" && !strings.ContainsAny(top, heavyRunes) && strings.ContainsAny(separators[1], heavyRunes)
+
+	// Rows under a separator drawn differently with = or a double rule are
+	// a footer. Other grid tables draw the header separator that way, so it
+	// has to differ from that one, unless the table is in grid style.
+	footer := len(body)
+	for k := len(body) - 1; k >= 1; k-- {
+		if last := separators[k+1]; strings.ContainsAny(last, heavyRunes) && (gridStyle || last != separators[1]) {
+			footer = k
+			break
+		}
+	}
+	if !gridStyle {
+		// Only the last run of rows can be the footer
+		if footer < len(body)-1 {
+			footer = len(body)
+		}
+	}
+
+	// Without a top border, as in psql and Org tables, every line is a row
+	// and separators only group them. Otherwise rules between rows mean each
+	// run of lines is one row.
+	grid := top != "" && (gridStyle || footer >= 2 || len(body)-footer >= 2)
+	rows := func(groups [][]textLine) []map[string]string {
+		var result []map[string]string
+		for _, group := range groups {
+			if !grid {
+				result = append(result, textRows(group, headers, split)...)
+				continue
+			}
+			row := make(map[string]string, len(headers))
+			for n, line := range group {
+				for i, cell := range split(line) {
+					if i >= len(headers) {
+						break
+					}
+					if n > 0 {
+						cell = row[headers[i]] + "\n" + cell
+					}
+					row[headers[i]] = cell
+				}
+			}
+			for h, cell := range row {
+				row[h] = strings.Trim(cell, "\n")
+			}
+			result = append(result, row)
+		}
+		return result
+	}
+	data := &TableData{Headers: headers, Rows: rows(body[:footer]), Footer: rows(body[footer:])}
+	return data, nil
+}
+
+// findTextTable finds the lines of the first table in the text, starting at
+// its first separator or at the header line just above it
+func findTextTable(lines []string) ([]textLine, error) {
+	start := -1
+	for i, line := range lines {
+		if isBorderLine([]rune(strings.TrimRight(line, " \t"))) {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		return nil, fmt.Errorf("no table border found in text")
+	}
+
+	first := strings.TrimLeft(lines[start], " ")
+	if first != "" && !strings.ContainsRune(junctionRunes, []rune(first)[0]) && start > 0 && strings.TrimSpace(lines[start-1]) != "" {
+		// psql style: the header sits above the first separator
+		start--
+	}
+
+	var table []textLine
+	for i := start; i < len(lines); i++ {
+		line := strings.TrimRight(lines[i], " \t")
+		if strings.TrimSpace(line) == "" || resultFooter.MatchString(line) {
+			break
+		}
+		runes := []rune(strings.ReplaceAll(line, "\t", "    "))
+		table = append(table, textLine{runes: runes, border: isBorderLine(runes)})
+	}
+	return table, nil
+}
+
+// isBorderLine reports whether a line is drawn only with border characters.
+// It needs a junction or vertical bar, so underlined headings and rules
+// made of dashes or = alone are not taken for a table.
+func isBorderLine(runes []rune) bool {
+	horizontal, corners := 0, 0
+	for _, r := range runes {
+		switch {
+		case strings.ContainsRune(horizontalRunes, r):
+			horizontal++
+		case strings.ContainsRune(junctionRunes, r) || strings.ContainsRune(verticalRunes, r):
+			corners++
+		case r == ' ':
+		default:
+			return false
+		}
+	}
+	return horizontal >= 3 && corners > 0
+}
+
+// junctions returns the positions where a separator meets column borders
+func junctions(runes []rune) []int {
+	var positions []int
+	for i, r := range runes {
+		if strings.ContainsRune(junctionRunes, r) || strings.ContainsRune(verticalRunes, r) {
+			positions = append(positions, i)
+		}
+	}
+	return positions
+}
+
+// splitTextRow cuts a row into cells at the column boundaries. Rows whose
+// borders do not line up, for example because of wide characters, are split
+// on their vertical bars instead.
+func splitTextRow(runes []rune, boundaries []int, outer bool) []string {
+	aligned := len(boundaries) > 0
+	for _, b := range boundaries {
+		if b >= len(runes) || !strings.ContainsRune(verticalRunes, runes[b]) && !strings.ContainsRune(junctionRunes, runes[b]) {
+			aligned = false
+			break
+		}
+	}
+
+	if !aligned {
+		line := strings.TrimSpace(string(runes))
+		var cells []string
+		var cell strings.Builder
+		for _, r := range line {
+			if !strings.ContainsRune(verticalRunes, r) {
+				cell.WriteRune(r)
+				continue
+			}
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		}
+		cells = append(cells, strings.TrimSpace(cell.String()))
+		if outer && len(cells) >= 2 {
+			cells = cells[1 : len(cells)-1]
+		}
+		return cells
+	}
+
+	cuts := boundaries
+	if !outer {
+		cuts = append(append([]int{-1}, boundaries...), len(runes))
+	}
+	cells := make([]string, 0, len(cuts)-1)
+	for i := 0; i+1 < len(cuts); i++ {
+		from, to := cuts[i]+1, cuts[i+1]
+		if from > len(runes) {
+			from = len(runes)
+		}
+		if to > len(runes) {
+			to = len(runes)
+		}
+		cells = append(cells, strings.TrimSpace(string(runes[from:to])))
+	}
+	return cells
+}
+
+// textRows turns one line per row into rows. psql marks a cell that goes on
+// in the next line with a trailing " +", and those lines are joined.
+func textRows(lines []textLine, headers []string, split func(textLine) []string) []map[string]string {
+	var rows []map[string]string
+	var continued map[string]bool
+	for _, line := range lines {
+		cells := split(line)
+		next := make(map[string]bool)
+
+		var row map[string]string
+		if continued != nil {
+			row = rows[len(rows)-1]
+		} else {
+			row = make(map[string]string, len(headers))
+			for _, h := range headers {
+				row[h] = ""
+			}
+			rows = append(rows, row)
+		}
+
+		for i, cell := range cells {
+			if i >= len(headers) {
+				break
+			}
+			h := headers[i]
+			if cell == "+" || strings.HasSuffix(cell, " +") {
+				cell = strings.TrimSpace(strings.TrimSuffix(cell, "+"))
+				next[h] = true
+			}
+			switch {
+			case continued == nil:
+				row[h] = cell
+			case continued[h]:
+				row[h] += "\n" + cell
+			case cell != "":
+				// Text under a column psql did not mark still joins the row
+				row[h] = strings.TrimPrefix(row[h]+"\n"+cell, "\n")
+			}
+		}
+
+		continued = nil
+		if len(next) > 0 {
+			continued = next
+		}
+	}
+	return rows
+}
@@ -0,0 +1,184 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestASCIIParser_Parse(t *testing.T) {
+	people := []map[string]string{
+		{"id": "1", "name": "Ann"},
+		{"id": "2", "name": "Bob Smith"},
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		want    *TableData
+		wantErr bool
+	}{
+		{
+			name: "gotable ASCII",
+			input: "+----+-----------+\n" +
+				"| id | name      |\n" +
+				"+----+-----------+\n" +
+				"| 1  | Ann       |\n" +
+				"| 2  | Bob Smith |\n" +
+				"+----+-----------+\n",
+			want: &TableData{Headers: []string{"id", "name"}, Rows: people},
+		},
+		{
+			name: "gotable ASCII With Footer",
+			input: "+-------+-----+\n" +
+				"| item  | qty |\n" +
+				"+-------+-----+\n" +
+				"| tea   | 2   |\n" +
+				"| milk  | 1   |\n" +
+				"+=======+=====+\n" +
+				"| total | 3   |\n" +
+				"+-------+-----+\n",
+			want: &TableData{
+				Headers: []string{"item", "qty"},
+				Rows: []map[string]string{
+					{"item": "tea", "qty": "2"},
+					{"item": "milk", "qty": "1"},
+				},
+				Footer: []map[string]string{{"item": "total", "qty": "3"}},
+			},
+		},
+		{
+			name: "MySQL",
+			input: "mysql> SELECT id, name FROM users;\n" +
+				"+----+-----------+\n" +
+				"| id | name      |\n" +
+				"+----+-----------+\n" +
+				"|  1 | Ann       |\n" +
+				"|  2 | Bob Smith |\n" +
+				"+----+-----------+\n" +
+				"2 rows in set (0.00 sec)\n",
+			want: &TableData{Headers: []string{"id", "name"}, Rows: people},
+		},
+		{
+			name: "psql",
+			input: " id |   name\n" +
+				"----+-----------\n" +
+				"  1 | Ann\n" +
+				"  2 | Bob Smith\n" +
+				"(2 rows)\n",
+			want: &TableData{Headers: []string{"id", "name"}, Rows: people},
+		},
+		{
+			name: "psql Multi-line Cells",
+			input: " id |  note\n" +
+				"----+--------\n" +
+				"  1 | first +\n" +
+				"    | second\n" +
+				"  2 | single\n" +
+				"(2 rows)\n",
+			want: &TableData{
+				Headers: []string{"id", "note"},
+				Rows: []map[string]string{
+					{"id": "1", "note": "first\nsecond"},
+					{"id": "2", "note": "single"},
+				},
+			},
+		},
+		{
+			name: "Unicode Box",
+			input: "┌────┬───────────┐\n" +
+				"│ id │ name      │\n" +
+				"├────┼───────────┤\n" +
+				"│ 1  │ Ann       │\n" +
+				"│ 2  │ Bob Smith │\n" +
+				"└────┴───────────┘\n",
+			want: &TableData{Headers: []string{"id", "name"}, Rows: people},
+		},
+		{
+			name: "Double Lines With Wide Characters",
+			input: "╔════╦═══════════╗\n" +
+				"║ id ║ name      ║\n" +
+				"╠════╬═══════════╣\n" +
+				"║ 1  ║ 日本語    ║\n" +
+				"║ 2  ║ Bob | Sue ║\n" +
+				"╚════╩═══════════╝\n",
+			want: &TableData{
+				Headers: []string{"id", "name"},
+				Rows: []map[string]string{
+					{"id": "1", "name": "日本語"},
+					{"id": "2", "name": "Bob | Sue"},
+				},
+			},
+		},
+		{
+			name: "Grid With Wrapped Cells",
+			input: "+----+---------+\n" +
+				"| id | long    |\n" +
+				"| #  | name    |\n" +
+				"+====+=========+\n" +
+				"| 1  | Ann     |\n" +
+				"|    | Lee     |\n" +
+				"+----+---------+\n" +
+				"| 2  | Bob     |\n" +
+				"+----+---------+\n",
+			want: &TableData{
+				Headers: []string{"id #", "long name"},
+				Rows: []map[string]string{
+					{"id #": "1", "long name": "Ann\nLee"},
+					{"id #": "2", "long name": "Bob"},
+				},
+			},
+		},
+		{
+			name: "Two Rows With Wrapped Cells",
+			input: "+----+---------+\n" +
+				"| id | name    |\n" +
+				"+----+---------+\n" +
+				"| 1  | Ann     |\n" +
+				"|    | Lee     |\n" +
+				"+----+---------+\n" +
+				"| 2  | Bob     |\n" +
+				"+----+---------+\n",
+			want: &TableData{
+				Headers: []string{"id", "name"},
+				Rows: []map[string]string{
+					{"id": "1", "name": "Ann\nLee"},
+					{"id": "2", "name": "Bob"},
+				},
+			},
+		},
+		{
+			name: "Underlined Heading",
+			input: "Users\n" +
+				"=====\n" +
+				"\n" +
+				"====== ======\n" +
+				"+----+\n" +
+				"| id |\n" +
+				"+----+\n" +
+				"| 1  |\n" +
+				"+----+\n",
+			want: &TableData{
+				Headers: []string{"id"},
+				Rows:    []map[string]string{{"id": "1"}},
+			},
+		},
+		{
+			name:    "No Table",
+			input:   "just some text\nwithout borders\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&ASCIIParser{}).Parse([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ASCIIParser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ASCIIParser.Parse() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
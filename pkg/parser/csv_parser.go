@@ -0,0 +1,183 @@
+package parser
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// CSVParser implements Parser for CSV input and other delimited text such as
+// TSV. The first record holds the headers.
+type CSVParser struct {
+	// Delimiter separates fields; when zero it is sniffed from the input
+	Delimiter rune
+	// Quote encloses fields holding delimiters or line breaks, '"' by default
+	// or sniffed along with the delimiter
+	Quote rune
+	// Comment marks lines to ignore when it is their first character
+	Comment rune
+	// LazyQuotes accepts quotes inside unquoted fields and stray quotes
+	// inside quoted ones
+	LazyQuotes bool
+	// TrimLeadingSpace ignores spaces and tabs at the start of each field
+	TrimLeadingSpace bool
+	// TooMany handles records with more fields than the header, FieldsExtra
+	// by default
+	TooMany FieldCountMode
+	// TooFew handles records with fewer fields than the header, FieldsPad by
+	// default
+	TooFew FieldCountMode
+	// Strict fails on any record with the wrong number of fields or with
+	// misplaced quotes. Otherwise such records are repaired and reported in
+	// the table's Warnings.
+	Strict bool
+}
+
+// FieldCountMode selects how records whose field count differs from the
+// header are handled
+type FieldCountMode string
+
+const (
+	// FieldsPad fills missing fields with empty cells, and adds "Column N"
+	// headers for surplus fields
+	FieldsPad FieldCountMode = "pad"
+	// FieldsTruncate drops surplus fields
+	FieldsTruncate FieldCountMode = "truncate"
+	// FieldsExtra keeps surplus fields, joined by the delimiter, in an
+	// _extra column
+	FieldsExtra FieldCountMode = "extra"
+	// FieldsError fails the parse
+	FieldsError FieldCountMode = "error"
+)
+
+// ExtraColumn is the header of the column holding surplus fields
+const ExtraColumn = "_extra"
+
+func (p *CSVParser) Parse(input []byte) (*TableData, error) {
+	tooMany, tooFew, err := p.fieldCountModes()
+	if err != nil {
+		return nil, err
+	}
+	if input, err = Decode(input, ""); err != nil {
+		return nil, err
+	}
+
+	dialect := p.dialect(string(input))
+	reader := newCSVReader(string(input), dialect)
+
+	var warnings []Warning
+	if !p.Strict {
+		reader.recovered = func(line int, err *csvError) {
+			warnings = append(warnings, Warning{Line: line, Message: err.msg + "; quotes read as text"})
+		}
+	}
+
+	// Read headers
+	headers, _, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("empty CSV input")
+	}
+	if err != nil {
+		return nil, err
+	}
+	headers = uniqueHeaders(headers)
+	width := len(headers)
+	extraColumn := ""
+
+	// Read rows
+	var rows []map[string]string
+	for {
+		record, line, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		row := make(map[string]string)
+		switch {
+		case len(record) > width && tooMany != FieldsPad:
+			if tooMany == FieldsError {
+				return nil, fmt.Errorf("line %d: wrong number of fields: got %d, want %d", line, len(record), width)
+			}
+			message := fmt.Sprintf("%d fields, expected %d; ", len(record), width)
+			if tooMany == FieldsExtra {
+				if extraColumn == "" {
+					headers = uniqueHeaders(append(headers, ExtraColumn))
+					extraColumn = headers[len(headers)-1]
+				}
+				row[extraColumn] = strings.Join(record[width:], string(dialect.comma))
+				message += "surplus fields kept in " + extraColumn
+			} else {
+				message += "surplus fields dropped"
+			}
+			warnings = append(warnings, Warning{Line: line, Message: message})
+			record = record[:width]
+		case len(record) > len(headers):
+			// Only reached in pad mode, where the header grows to fit
+			warnings = append(warnings, Warning{Line: line, Message: fmt.Sprintf("%d fields, expected %d; columns added", len(record), len(headers))})
+			headers = uniqueHeaders(append(headers, make([]string, len(record)-len(headers))...))
+		case len(record) < width:
+			if tooFew == FieldsError {
+				return nil, fmt.Errorf("line %d: wrong number of fields: got %d, want %d", line, len(record), width)
+			}
+			warnings = append(warnings, Warning{Line: line, Message: fmt.Sprintf("%d fields, expected %d; missing fields left empty", len(record), width)})
+			for _, h := range headers[len(record):width] {
+				row[h] = ""
+			}
+		}
+
+		for i, value := range record {
+			row[headers[i]] = value
+		}
+		rows = append(rows, row)
+	}
+
+	return &TableData{
+		Headers:  headers,
+		Rows:     rows,
+		Warnings: warnings,
+	}, nil
+}
+
+// fieldCountModes applies the defaults and Strict to TooMany and TooFew
+func (p *CSVParser) fieldCountModes() (tooMany, tooFew FieldCountMode, err error) {
+	if p.Strict {
+		return FieldsError, FieldsError, nil
+	}
+
+	tooMany, tooFew = p.TooMany, p.TooFew
+	if tooMany == "" {
+		tooMany = FieldsExtra
+	}
+	if tooFew == "" {
+		tooFew = FieldsPad
+	}
+	switch tooMany {
+	case FieldsPad, FieldsTruncate, FieldsExtra, FieldsError:
+	default:
+		return "", "", fmt.Errorf("unknown mode %q for records with too many fields", tooMany)
+	}
+	switch tooFew {
+	case FieldsPad, FieldsError:
+	default:
+		return "", "", fmt.Errorf("unknown mode %q for records with too few fields", tooFew)
+	}
+	return tooMany, tooFew, nil
+}
+
+// dialect fills in the settings left unset by sniffing the input
+func (p *CSVParser) dialect(input string) csvDialect {
+	dialect := csvDialect{
+		comma:            p.Delimiter,
+		quote:            p.Quote,
+		comment:          p.Comment,
+		lazyQuotes:       p.LazyQuotes,
+		trimLeadingSpace: p.TrimLeadingSpace,
+	}
+	if dialect.comma == 0 || dialect.quote == 0 {
+		dialect = sniffDialect(input, dialect)
+	}
+	return dialect
+}
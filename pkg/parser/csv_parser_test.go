@@ -0,0 +1,243 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCSVParser_Parse(t *testing.T) {
+	people := []map[string]string{
+		{"name": "John", "city": "New York"},
+		{"name": "Anna", "city": "Zürich"},
+	}
+
+	tests := []struct {
+		name    string
+		parser  *CSVParser
+		input   string
+		want    []map[string]string
+		wantErr bool
+	}{
+		{
+			name:   "Comma",
+			parser: &CSVParser{},
+			input:  "name,city\nJohn,New York\nAnna,Zürich\n",
+			want:   people,
+		},
+		{
+			name:   "Sniffed Semicolon",
+			parser: &CSVParser{},
+			input:  "name;city\r\nJohn;New York\r\nAnna;Zürich\r\n",
+			want:   people,
+		},
+		{
+			name:   "Sniffed Tab",
+			parser: &CSVParser{},
+			input:  "name\tcity\nJohn\tNew York\nAnna\tZürich",
+			want:   people,
+		},
+		{
+			name:   "Explicit Pipe",
+			parser: &CSVParser{Delimiter: '|'},
+			input:  "name|city\nJohn|New York\nAnna|Zürich\n",
+			want:   people,
+		},
+		{
+			name:   "Quoted Fields",
+			parser: &CSVParser{},
+			input:  "name,city\n\"John\",\"New York\"\n\"Anna\",\"Zürich\"\n",
+			want:   people,
+		},
+		{
+			name:   "Single Quotes",
+			parser: &CSVParser{Quote: '\''},
+			input:  "name,city\n'John','New York'\nAnna,'Zürich'\n",
+			want:   people,
+		},
+		{
+			name:   "Comments And Leading Space",
+			parser: &CSVParser{Comment: '#', TrimLeadingSpace: true},
+			input:  "# exported 2024-01-01\nname, city\n# skipped\nJohn,   New York\nAnna, Zürich\n",
+			want:   people,
+		},
+		{
+			name:   "Embedded Delimiters, Quotes And Newlines",
+			parser: &CSVParser{},
+			input:  "name,city\n\"Doe, John\",\"New\nYork\"\n\"Anna \"\"A\"\"\",Zürich\n",
+			want: []map[string]string{
+				{"name": "Doe, John", "city": "New\nYork"},
+				{"name": `Anna "A"`, "city": "Zürich"},
+			},
+		},
+		{
+			name:   "Lazy Quotes",
+			parser: &CSVParser{Delimiter: ',', LazyQuotes: true},
+			input:  "name,city\nJo\"hn,New York\n\"Anna \"A\" B\",Zürich\n",
+			want: []map[string]string{
+				{"name": `Jo"hn`, "city": "New York"},
+				{"name": `Anna "A" B`, "city": "Zürich"},
+			},
+		},
+		{
+			name:    "Bare Quote In Strict Mode",
+			parser:  &CSVParser{Delimiter: ',', Strict: true},
+			input:   "name,city\nJo\"hn,New York\n",
+			wantErr: true,
+		},
+		{
+			name:    "Unterminated Quote",
+			parser:  &CSVParser{},
+			input:   "name,city\n\"John,New York\n",
+			wantErr: true,
+		},
+		{
+			name:    "Empty Input",
+			parser:  &CSVParser{},
+			input:   "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parser.Parse([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CSVParser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got.Headers, []string{"name", "city"}) {
+				t.Errorf("CSVParser.Parse() headers = %q, want [name city]", got.Headers)
+			}
+			if !reflect.DeepEqual(got.Rows, tt.want) {
+				t.Errorf("CSVParser.Parse() rows = %q, want %q", got.Rows, tt.want)
+			}
+		})
+	}
+}
+
+func TestCSVParser_RaggedRows(t *testing.T) {
+	input := "name,city\nJohn,New York\nAnna\nBob,Paris,FR,EU\n"
+
+	tests := []struct {
+		name         string
+		parser       *CSVParser
+		wantHeaders  []string
+		wantRows     []map[string]string
+		wantWarnings []Warning
+		wantErr      bool
+	}{
+		{
+			name:        "Default",
+			parser:      &CSVParser{},
+			wantHeaders: []string{"name", "city", "_extra"},
+			wantRows: []map[string]string{
+				{"name": "John", "city": "New York"},
+				{"name": "Anna", "city": ""},
+				{"name": "Bob", "city": "Paris", "_extra": "FR,EU"},
+			},
+			wantWarnings: []Warning{
+				{Line: 3, Message: "1 fields, expected 2; missing fields left empty"},
+				{Line: 4, Message: "4 fields, expected 2; surplus fields kept in _extra"},
+			},
+		},
+		{
+			name:        "Pad",
+			parser:      &CSVParser{TooMany: FieldsPad},
+			wantHeaders: []string{"name", "city", "Column 3", "Column 4"},
+			wantRows: []map[string]string{
+				{"name": "John", "city": "New York"},
+				{"name": "Anna", "city": ""},
+				{"name": "Bob", "city": "Paris", "Column 3": "FR", "Column 4": "EU"},
+			},
+			wantWarnings: []Warning{
+				{Line: 3, Message: "1 fields, expected 2; missing fields left empty"},
+				{Line: 4, Message: "4 fields, expected 2; columns added"},
+			},
+		},
+		{
+			name:        "Truncate",
+			parser:      &CSVParser{TooMany: FieldsTruncate},
+			wantHeaders: []string{"name", "city"},
+			wantRows: []map[string]string{
+				{"name": "John", "city": "New York"},
+				{"name": "Anna", "city": ""},
+				{"name": "Bob", "city": "Paris"},
+			},
+			wantWarnings: []Warning{
+				{Line: 3, Message: "1 fields, expected 2; missing fields left empty"},
+				{Line: 4, Message: "4 fields, expected 2; surplus fields dropped"},
+			},
+		},
+		{
+			name:    "Too Few Error",
+			parser:  &CSVParser{TooFew: FieldsError},
+			wantErr: true,
+		},
+		{
+			name:    "Too Many Error",
+			parser:  &CSVParser{TooMany: FieldsError},
+			wantErr: true,
+		},
+		{
+			name:    "Strict",
+			parser:  &CSVParser{Strict: true},
+			wantErr: true,
+		},
+		{
+			name:    "Unknown Mode",
+			parser:  &CSVParser{TooFew: FieldsExtra},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parser.Parse([]byte(input))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CSVParser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got.Headers, tt.wantHeaders) {
+				t.Errorf("CSVParser.Parse() headers = %q, want %q", got.Headers, tt.wantHeaders)
+			}
+			if !reflect.DeepEqual(got.Rows, tt.wantRows) {
+				t.Errorf("CSVParser.Parse() rows = %q, want %q", got.Rows, tt.wantRows)
+			}
+			if !reflect.DeepEqual(got.Warnings, tt.wantWarnings) {
+				t.Errorf("CSVParser.Parse() warnings = %v, want %v", got.Warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestCSVParser_StrayQuotes(t *testing.T) {
+	input := "name,city\nJo\"hn,New York\n\"Anna\" B,Zürich\nBob,Paris\n"
+
+	got, err := (&CSVParser{Delimiter: ','}).Parse([]byte(input))
+	if err != nil {
+		t.Fatalf("CSVParser.Parse() error = %v", err)
+	}
+
+	wantRows := []map[string]string{
+		{"name": `Jo"hn`, "city": "New York"},
+		{"name": `"Anna" B`, "city": "Zürich"},
+		{"name": "Bob", "city": "Paris"},
+	}
+	if !reflect.DeepEqual(got.Rows, wantRows) {
+		t.Errorf("CSVParser.Parse() rows = %q, want %q", got.Rows, wantRows)
+	}
+
+	wantWarnings := []Warning{
+		{Line: 2, Message: "bare \" in non-quoted field; quotes read as text"},
+		{Line: 3, Message: "extraneous or missing \" in quoted field; quotes read as text"},
+	}
+	if !reflect.DeepEqual(got.Warnings, wantWarnings) {
+		t.Errorf("CSVParser.Parse() warnings = %v, want %v", got.Warnings, wantWarnings)
+	}
+}
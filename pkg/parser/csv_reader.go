@@ -0,0 +1,282 @@
+package parser
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// csvDialect describes how a delimited text file is laid out
+type csvDialect struct {
+	comma            rune
+	quote            rune
+	comment          rune
+	lazyQuotes       bool
+	trimLeadingSpace bool
+}
+
+// csvReader reads records from delimited text. Unlike encoding/csv it
+// supports any quote character and reports the line each record starts on.
+type csvReader struct {
+	dialect csvDialect
+	data    string
+	pos     int
+	line    int
+	// recovered, when set, is called for records with misplaced quotes,
+	// which are then re-read treating quotes as plain text
+	recovered func(line int, err *csvError)
+}
+
+// csvError reports malformed quoting in a record
+type csvError struct {
+	line int
+	msg  string
+	// unterminated marks a quoted field that runs to the end of the input
+	unterminated bool
+}
+
+func (e *csvError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.line, e.msg)
+}
+
+func newCSVReader(input string, dialect csvDialect) *csvReader {
+	return &csvReader{dialect: dialect, data: input, line: 1}
+}
+
+func (r *csvReader) next() (rune, bool) {
+	if r.pos >= len(r.data) {
+		return 0, false
+	}
+	c, size := utf8.DecodeRuneInString(r.data[r.pos:])
+	r.pos += size
+	if c == '\n' {
+		r.line++
+	}
+	return c, true
+}
+
+func (r *csvReader) peek() (rune, bool) {
+	if r.pos >= len(r.data) {
+		return 0, false
+	}
+	c, _ := utf8.DecodeRuneInString(r.data[r.pos:])
+	return c, true
+}
+
+// skipLine moves past the end of the current line
+func (r *csvReader) skipLine() {
+	if end := strings.IndexByte(r.data[r.pos:], '\n'); end >= 0 {
+		r.pos += end + 1
+		r.line++
+	} else {
+		r.pos = len(r.data)
+	}
+}
+
+// Read returns the next record and the line it starts on. Blank lines and
+// comment lines are skipped; io.EOF is returned at the end of the input.
+func (r *csvReader) Read() ([]string, int, error) {
+	// Skip blank and comment lines
+	for {
+		if r.pos >= len(r.data) {
+			return nil, r.line, io.EOF
+		}
+		c, _ := r.peek()
+		switch {
+		case c == '\n':
+			r.next()
+		case c == '\r' && strings.HasPrefix(r.data[r.pos:], "\r\n"):
+			r.next()
+			r.next()
+		case r.dialect.comment != 0 && c == r.dialect.comment:
+			r.skipLine()
+		default:
+			return r.readRecord()
+		}
+	}
+}
+
+func (r *csvReader) readRecord() ([]string, int, error) {
+	start, startPos := r.line, r.pos
+	record, err := r.readFields()
+	if err == nil {
+		return record, start, nil
+	}
+
+	cerr, ok := err.(*csvError)
+	if !ok || cerr.unterminated || r.recovered == nil || r.dialect.lazyQuotes {
+		return nil, start, err
+	}
+
+	// Re-read the record with quotes taken as plain text, which also stops
+	// it at the end of the first line
+	r.pos, r.line = startPos, start
+	quote := r.dialect.quote
+	r.dialect.quote = -1
+	record, err = r.readFields()
+	r.dialect.quote = quote
+	if err != nil {
+		return nil, start, err
+	}
+	r.recovered(start, cerr)
+	return record, start, nil
+}
+
+func (r *csvReader) readFields() ([]string, error) {
+	var record []string
+	for {
+		field, last, err := r.readField()
+		if err != nil {
+			return nil, err
+		}
+		record = append(record, field)
+		if last {
+			return record, nil
+		}
+	}
+}
+
+// readField reads one field and reports whether it ended the record
+func (r *csvReader) readField() (string, bool, error) {
+	d := r.dialect
+	if d.trimLeadingSpace {
+		for c, ok := r.peek(); ok && (c == ' ' || c == '\t'); c, ok = r.peek() {
+			r.next()
+		}
+	}
+
+	var field strings.Builder
+	if c, ok := r.peek(); ok && c == d.quote {
+		r.next()
+		quoteLine := r.line
+		for {
+			c, ok := r.next()
+			if !ok {
+				if d.lazyQuotes {
+					return field.String(), true, nil
+				}
+				return "", false, &csvError{line: r.line, msg: fmt.Sprintf("unterminated quoted field starting on line %d", quoteLine), unterminated: true}
+			}
+			if c == '\r' && strings.HasPrefix(r.data[r.pos:], "\n") {
+				continue
+			}
+			if c != d.quote {
+				field.WriteRune(c)
+				continue
+			}
+
+			// A quote either escapes another quote or closes the field
+			n, ok := r.peek()
+			switch {
+			case ok && n == d.quote:
+				r.next()
+				field.WriteRune(d.quote)
+			case !ok || n == d.comma || n == '\n' || (n == '\r' && strings.HasPrefix(r.data[r.pos:], "\r\n")):
+				return field.String(), r.endField(), nil
+			case d.lazyQuotes:
+				field.WriteRune(c)
+			default:
+				return "", false, &csvError{line: r.line, msg: fmt.Sprintf("extraneous or missing %c in quoted field", d.quote)}
+			}
+		}
+	}
+
+	for {
+		c, ok := r.peek()
+		if !ok || c == d.comma || c == '\n' || (c == '\r' && strings.HasPrefix(r.data[r.pos:], "\r\n")) {
+			return field.String(), r.endField(), nil
+		}
+		r.next()
+		if c == d.quote && !d.lazyQuotes {
+			return "", false, &csvError{line: r.line, msg: fmt.Sprintf("bare %c in non-quoted field", d.quote)}
+		}
+		field.WriteRune(c)
+	}
+}
+
+// endField consumes the delimiter or line break after a field and reports
+// whether the record is complete
+func (r *csvReader) endField() bool {
+	c, ok := r.next()
+	if !ok || c == '\n' {
+		return true
+	}
+	if c == '\r' {
+		r.next()
+		return true
+	}
+	return false
+}
+
+// sniffDialect guesses the delimiter and quote character from the first
+// lines of the input, preferring the delimiter that splits the sample into
+// the same number of fields on every line
+func sniffDialect(input string, dialect csvDialect) csvDialect {
+	sample := input
+	lines := 0
+	for i := 0; i < len(sample); i++ {
+		if sample[i] == '\n' {
+			lines++
+			if lines == 20 {
+				sample = sample[:i+1]
+				break
+			}
+		}
+	}
+
+	if dialect.quote == 0 {
+		dialect.quote = '"'
+		if !strings.Contains(sample, `"`) && looksQuoted(sample, '\'') {
+			dialect.quote = '\''
+		}
+	}
+	if dialect.comma != 0 {
+		return dialect
+	}
+
+	dialect.comma = ','
+	bestScore := 0
+	for _, candidate := range []rune{',', ';', '\t', '|'} {
+		trial := dialect
+		trial.comma = candidate
+		trial.lazyQuotes = true
+
+		counts := make(map[int]int)
+		reader := newCSVReader(sample, trial)
+		for {
+			record, _, err := reader.Read()
+			if err != nil {
+				break
+			}
+			counts[len(record)]++
+		}
+
+		// Score by how many lines agree on a field count above one
+		for fields, n := range counts {
+			if fields < 2 {
+				continue
+			}
+			score := n*1000 + fields
+			if score > bestScore {
+				bestScore = score
+				dialect.comma = candidate
+			}
+		}
+	}
+	return dialect
+}
+
+// looksQuoted reports whether some field in the sample opens with quote
+func looksQuoted(sample string, quote rune) bool {
+	q := string(quote)
+	if strings.HasPrefix(sample, q) || strings.Contains(sample, "\n"+q) {
+		return true
+	}
+	for _, delimiter := range []string{",", ";", "\t", "|"} {
+		if strings.Contains(sample, delimiter+q) {
+			return true
+		}
+	}
+	return false
+}
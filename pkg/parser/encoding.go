@@ -0,0 +1,140 @@
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/encoding/ianaindex"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/encoding/unicode/utf32"
+)
+
+// byteOrderMarks maps each BOM to the encoding it announces. UTF-32LE comes
+// before UTF-16LE as its BOM starts with the same bytes.
+var byteOrderMarks = []struct {
+	bom      []byte
+	encoding encoding.Encoding
+}{
+	{[]byte{0xEF, 0xBB, 0xBF}, unicode.UTF8},
+	{[]byte{0xFF, 0xFE, 0x00, 0x00}, utf32.UTF32(utf32.LittleEndian, utf32.IgnoreBOM)},
+	{[]byte{0x00, 0x00, 0xFE, 0xFF}, utf32.UTF32(utf32.BigEndian, utf32.IgnoreBOM)},
+	{[]byte{0xFF, 0xFE}, unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM)},
+	{[]byte{0xFE, 0xFF}, unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM)},
+}
+
+// utf8BOM is the UTF-8 encoding of U+FEFF
+var utf8BOM = byteOrderMarks[0].bom
+
+// Decode converts input in the named encoding to UTF-8 and strips any byte
+// order mark. Names are WHATWG or IANA labels such as windows-1252, latin1,
+// shift_jis or utf-16le. With an empty name the encoding is detected: a BOM
+// wins, then UTF-16 without a BOM, then UTF-8, and anything else is read as
+// Windows-1252.
+func Decode(input []byte, name string) ([]byte, error) {
+	var enc encoding.Encoding
+	if name == "" || strings.EqualFold(name, "auto") {
+		var bom []byte
+		enc, bom = detectEncoding(input)
+		input = input[len(bom):]
+	} else {
+		var err error
+		if enc, err = lookupEncoding(name); err != nil {
+			return nil, err
+		}
+	}
+
+	return decodeWith(input, enc)
+}
+
+// decodeWith converts input from enc to UTF-8, dropping a leading BOM
+func decodeWith(input []byte, enc encoding.Encoding) ([]byte, error) {
+	if enc != unicode.UTF8 {
+		var err error
+		if input, err = enc.NewDecoder().Bytes(input); err != nil {
+			return nil, fmt.Errorf("failed to decode input as %v: %v", enc, err)
+		}
+	}
+	return bytes.TrimPrefix(input, utf8BOM), nil
+}
+
+// detectEncoding guesses the encoding of input, returning the BOM it starts
+// with, if any
+func detectEncoding(input []byte) (encoding.Encoding, []byte) {
+	for _, m := range byteOrderMarks {
+		if bytes.HasPrefix(input, m.bom) {
+			return m.encoding, m.bom
+		}
+	}
+	if enc := detectUTF16(input); enc != nil {
+		return enc, nil
+	}
+	if utf8.Valid(input) {
+		return unicode.UTF8, nil
+	}
+	return charmap.Windows1252, nil
+}
+
+// detectUTF16 recognises UTF-16 text without a BOM by the zero bytes that
+// ASCII characters leave in every other position
+func detectUTF16(input []byte) encoding.Encoding {
+	sample := input
+	if len(sample) > 1024 {
+		sample = sample[:1024]
+	}
+	if len(sample) < 4 {
+		return nil
+	}
+
+	var even, odd int
+	for i, b := range sample {
+		if b != 0 {
+			continue
+		}
+		if i%2 == 0 {
+			even++
+		} else {
+			odd++
+		}
+	}
+	half := len(sample) / 2
+	switch {
+	case odd > half/2 && even == 0:
+		return unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM)
+	case even > half/2 && odd == 0:
+		return unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM)
+	}
+	return nil
+}
+
+// lookupEncoding finds an encoding by its WHATWG or IANA label
+func lookupEncoding(name string) (encoding.Encoding, error) {
+	switch strings.ToLower(strings.ReplaceAll(name, "_", "-")) {
+	case "utf-8", "utf8":
+		return unicode.UTF8, nil
+	case "utf-16", "utf16":
+		return unicode.UTF16(unicode.LittleEndian, unicode.UseBOM), nil
+	case "utf-16le":
+		return unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM), nil
+	case "utf-16be":
+		return unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM), nil
+	case "utf-32", "utf32":
+		return utf32.UTF32(utf32.LittleEndian, utf32.UseBOM), nil
+	case "utf-32le":
+		return utf32.UTF32(utf32.LittleEndian, utf32.IgnoreBOM), nil
+	case "utf-32be":
+		return utf32.UTF32(utf32.BigEndian, utf32.IgnoreBOM), nil
+	}
+
+	if enc, err := htmlindex.Get(name); err == nil {
+		return enc, nil
+	}
+	if enc, err := ianaindex.IANA.Encoding(name); err == nil && enc != nil {
+		return enc, nil
+	}
+	return nil, fmt.Errorf("unsupported encoding: %s", name)
+}
@@ -0,0 +1,80 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+// utf16LE encodes text from the Basic Multilingual Plane as UTF-16LE
+func utf16LE(s string) []byte {
+	var b []byte
+	for _, c := range s {
+		b = append(b, byte(c), byte(c>>8))
+	}
+	return b
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		encoding string
+		want     string
+		wantErr  bool
+	}{
+		{"Plain UTF-8", []byte("naïve,café"), "", "naïve,café", false},
+		{"UTF-8 BOM", []byte("\xEF\xBB\xBFname,city"), "", "name,city", false},
+		{"UTF-16LE BOM", append([]byte{0xFF, 0xFE}, utf16LE("a,b\n1,2")...), "", "a,b\n1,2", false},
+		{"UTF-16BE BOM", []byte{0xFE, 0xFF, 0, 'h', 0, 'i', 0x00, 0xE9}, "", "hié", false},
+		{"UTF-16LE Without BOM", utf16LE("name,city\nJohn,Paris"), "", "name,city\nJohn,Paris", false},
+		{"UTF-32LE BOM", []byte{0xFF, 0xFE, 0, 0, 'o', 0, 0, 0, 'k', 0, 0, 0}, "", "ok", false},
+		{"Windows-1252 Fallback", []byte("caf\xe9 \x80"), "", "café €", false},
+		{"Explicit Latin-1", []byte("caf\xe9"), "latin1", "café", false},
+		{"Explicit Shift JIS", []byte{0x93, 0xfa, 0x96, 0x7b}, "shift_jis", "日本", false},
+		{"Explicit UTF-16LE With BOM", append([]byte{0xFF, 0xFE}, utf16LE("x")...), "utf-16le", "x", false},
+		{"Explicit UTF-8 With BOM", []byte("\xEF\xBB\xBFx"), "utf-8", "x", false},
+		{"Unsupported", []byte("x"), "klingon", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Decode(tt.input, tt.encoding)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Decode() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if string(got) != tt.want {
+				t.Errorf("Decode() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsersDecodeInput(t *testing.T) {
+	want := []map[string]string{{"name": "Zoë", "city": "Paris"}}
+
+	tests := []struct {
+		name   string
+		parser Parser
+		input  []byte
+	}{
+		{"CSV UTF-16 From Excel", &CSVParser{}, append([]byte{0xFF, 0xFE}, utf16LE("name\tcity\r\nZoë\tParis\r\n")...)},
+		{"CSV Windows-1252", &CSVParser{}, []byte("name,city\nZo\xeb,Paris\n")},
+		{"JSON UTF-8 BOM", &JSONParser{}, []byte("\xEF\xBB\xBF[{\"name\":\"Zoë\",\"city\":\"Paris\"}]")},
+		{"XML UTF-16", &XMLParser{}, append([]byte{0xFF, 0xFE}, utf16LE("<?xml version=\"1.0\" encoding=\"UTF-16\"?><r><p><name>Zoë</name><city>Paris</city></p><p><name>Zoë</name><city>Paris</city></p></r>")...)},
+		{"XML Declared Latin-1", &XMLParser{}, []byte("<?xml version=\"1.0\" encoding=\"ISO-8859-1\"?><r><p><name>Zo\xeb</name><city>Paris</city></p></r>")},
+		{"HTML Meta Charset", &HTMLParser{}, []byte("<meta charset=\"windows-1252\"><table><tr><th>name</th><th>city</th></tr><tr><td>Zo\xeb</td><td>Paris</td></tr></table>")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parser.Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.Rows[:1], want) {
+				t.Errorf("Parse() rows = %q, want %q", got.Rows, want)
+			}
+		})
+	}
+}
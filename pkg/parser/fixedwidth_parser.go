@@ -0,0 +1,205 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FixedWidthParser implements Parser for fixed-width text, such as mainframe
+// exports and printed reports. Columns are given explicitly or inferred from
+// the runs of blanks shared by every line. Underline rows (----- or =====)
+// are skipped and cells are trimmed.
+type FixedWidthParser struct {
+	// Columns lays out the columns. Columns without a name take theirs from
+	// the first line; when every column is named, the first line is data.
+	// When empty the layout is inferred and the first line is the header.
+	Columns []FixedColumn
+}
+
+// FixedColumn is a column of fixed-width text, spanning the characters from
+// Start up to but not including End, counted from 0. An End of 0 runs to
+// the end of the line.
+type FixedColumn struct {
+	Name  string
+	Start int
+	End   int
+}
+
+// ParseColumnSpec reads a comma separated column layout. Each column is
+// written as name:first-last, with 1-based inclusive positions, or as
+// name:width, starting where the previous column ends. The name may be left
+// out to take it from the header line, as in "1-10,11-13" or "10,3".
+func ParseColumnSpec(spec string) ([]FixedColumn, error) {
+	var columns []FixedColumn
+	next := 0
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		var column FixedColumn
+		layout := item
+		if i := strings.LastIndex(item, ":"); i >= 0 {
+			column.Name, layout = strings.TrimSpace(item[:i]), item[i+1:]
+		}
+
+		if first, last, ok := strings.Cut(layout, "-"); ok {
+			start, err1 := strconv.Atoi(strings.TrimSpace(first))
+			end, err2 := strconv.Atoi(strings.TrimSpace(last))
+			if err1 != nil || err2 != nil || start < 1 || end < start {
+				return nil, fmt.Errorf("invalid column range %q", item)
+			}
+			column.Start, column.End = start-1, end
+		} else {
+			width, err := strconv.Atoi(strings.TrimSpace(layout))
+			if err != nil || width < 1 {
+				return nil, fmt.Errorf("invalid column width %q", item)
+			}
+			column.Start, column.End = next, next+width
+		}
+		next = column.End
+		columns = append(columns, column)
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("empty column spec")
+	}
+	return columns, nil
+}
+
+func (p *FixedWidthParser) Parse(input []byte) (*TableData, error) {
+	input, err := Decode(input, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var lines [][]rune
+	for _, line := range strings.Split(strings.ReplaceAll(string(input), "\r\n", "\n"), "\n") {
+		line = strings.TrimRight(strings.ReplaceAll(line, "\t", "    "), " ")
+		if line != "" {
+			lines = append(lines, []rune(line))
+		}
+	}
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("empty fixed-width input")
+	}
+
+	columns := p.Columns
+	readHeader := len(columns) == 0
+	for _, c := range columns {
+		if c.Name == "" {
+			readHeader = true
+		}
+	}
+	if len(columns) == 0 {
+		columns = inferColumns(lines)
+	}
+
+	var headers []string
+	if readHeader {
+		header := lines[0]
+		lines = lines[1:]
+		for _, c := range columns {
+			name := c.Name
+			if name == "" {
+				name = fixedCell(header, c)
+			}
+			headers = append(headers, name)
+		}
+	} else {
+		for _, c := range columns {
+			headers = append(headers, c.Name)
+		}
+	}
+	headers = uniqueHeaders(headers)
+
+	var rows []map[string]string
+	for _, line := range lines {
+		if isUnderline(line) {
+			continue
+		}
+		row := make(map[string]string, len(headers))
+		for i, c := range columns {
+			row[headers[i]] = fixedCell(line, c)
+		}
+		rows = append(rows, row)
+	}
+
+	return &TableData{
+		Headers: headers,
+		Rows:    rows,
+	}, nil
+}
+
+// fixedCell cuts a column out of a line and trims its padding
+func fixedCell(line []rune, c FixedColumn) string {
+	start, end := c.Start, c.End
+	if end == 0 || end > len(line) {
+		end = len(line)
+	}
+	if start >= end {
+		return ""
+	}
+	return strings.TrimSpace(string(line[start:end]))
+}
+
+// isUnderline reports whether a line only underlines the header
+func isUnderline(line []rune) bool {
+	dashes := 0
+	for _, r := range line {
+		switch r {
+		case '-', '=':
+			dashes++
+		case ' ', '+', '|':
+		default:
+			return false
+		}
+	}
+	return dashes > 0
+}
+
+// inferColumns works out the layout from the blanks every line shares. An
+// underline row, when there is one, marks the columns directly. Each column
+// runs up to the start of the next one so values spilling over the header
+// are kept whole.
+func inferColumns(lines [][]rune) []FixedColumn {
+	var filled []bool
+	for _, line := range lines {
+		if isUnderline(line) && len(lines) > 1 {
+			filled = make([]bool, len(line))
+			for i, r := range line {
+				filled[i] = r == '-' || r == '='
+			}
+			break
+		}
+	}
+
+	if filled == nil {
+		for _, line := range lines {
+			for len(filled) < len(line) {
+				filled = append(filled, false)
+			}
+			for i, r := range line {
+				if r != ' ' {
+					filled[i] = true
+				}
+			}
+		}
+	}
+
+	var columns []FixedColumn
+	for i := range filled {
+		if filled[i] && (i == 0 || !filled[i-1]) {
+			if len(columns) > 0 {
+				columns[len(columns)-1].End = i
+			}
+			columns = append(columns, FixedColumn{Start: i})
+		}
+	}
+	if len(columns) > 0 {
+		// The first column also takes any indentation
+		columns[0].Start = 0
+	}
+	return columns
+}
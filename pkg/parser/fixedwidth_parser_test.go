@@ -0,0 +1,115 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseColumnSpec(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		want    []FixedColumn
+		wantErr bool
+	}{
+		{"Ranges", "id:1-4, name:6-15", []FixedColumn{{"id", 0, 4}, {"name", 5, 15}}, false},
+		{"Widths", "id:4,name:10,city:8", []FixedColumn{{"id", 0, 4}, {"name", 4, 14}, {"city", 14, 22}}, false},
+		{"Unnamed", "4,10", []FixedColumn{{"", 0, 4}, {"", 4, 14}}, false},
+		{"Bad Range", "id:5-2", nil, true},
+		{"Bad Width", "id:x", nil, true},
+		{"Empty", "", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseColumnSpec(tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseColumnSpec() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseColumnSpec() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFixedWidthParser_Parse(t *testing.T) {
+	tests := []struct {
+		name    string
+		parser  *FixedWidthParser
+		input   string
+		want    *TableData
+		wantErr bool
+	}{
+		{
+			name:   "Inferred With Underline",
+			parser: &FixedWidthParser{},
+			input: "ID   Name         Balance\n" +
+				"---- ------------ -------\n" +
+				"1    Ann Lee        10.50\n" +
+				"22   Bob             3.00\n",
+			want: &TableData{
+				Headers: []string{"ID", "Name", "Balance"},
+				Rows: []map[string]string{
+					{"ID": "1", "Name": "Ann Lee", "Balance": "10.50"},
+					{"ID": "22", "Name": "Bob", "Balance": "3.00"},
+				},
+			},
+		},
+		{
+			name:   "Inferred From Blanks",
+			parser: &FixedWidthParser{},
+			input: "code  city       pop\n" +
+				"AMS   Amsterdam  905\n" +
+				"NYC   New York   8336\n",
+			want: &TableData{
+				Headers: []string{"code", "city", "pop"},
+				Rows: []map[string]string{
+					{"code": "AMS", "city": "Amsterdam", "pop": "905"},
+					{"code": "NYC", "city": "New York", "pop": "8336"},
+				},
+			},
+		},
+		{
+			name:   "Named Columns Without Header",
+			parser: &FixedWidthParser{Columns: []FixedColumn{{"acct", 0, 6}, {"type", 6, 7}, {"amount", 7, 0}}},
+			input:  "000123C0000042\n000456D0001000\n",
+			want: &TableData{
+				Headers: []string{"acct", "type", "amount"},
+				Rows: []map[string]string{
+					{"acct": "000123", "type": "C", "amount": "0000042"},
+					{"acct": "000456", "type": "D", "amount": "0001000"},
+				},
+			},
+		},
+		{
+			name:   "Unnamed Columns Read The Header",
+			parser: &FixedWidthParser{Columns: []FixedColumn{{"", 0, 3}, {"", 3, 0}}},
+			input:  "No Item\n=======\n1  Tea\n",
+			want: &TableData{
+				Headers: []string{"No", "Item"},
+				Rows:    []map[string]string{{"No": "1", "Item": "Tea"}},
+			},
+		},
+		{
+			name:    "Empty",
+			parser:  &FixedWidthParser{},
+			input:   "\n\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parser.Parse([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FixedWidthParser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FixedWidthParser.Parse() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,78 +3,375 @@ package parser
 import (
 	"bytes"
 	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/andybalholm/cascadia"
 	"golang.org/x/net/html"
+	"golang.org/x/net/html/charset"
+	"golang.org/x/text/encoding/charmap"
 )
 
-type HTMLParser struct{}
+type HTMLParser struct {
+	// HeaderSeparator joins the labels of multi-row headers into one column name
+	HeaderSeparator string
+	// Emphasis keeps bold, italic and code formatting in the rich cell content;
+	// links and line breaks are always kept
+	Emphasis bool
+
+	// Table selection; the filters combine and Index picks among the matches
+	Index    int
+	ID       string
+	Class    string
+	Selector string
+	Caption  string
+}
+
+// htmlCell is a single slot of the expanded table grid
+type htmlCell struct {
+	text   string
+	rich   RichText
+	header bool
+}
+
+// htmlSection groups the rows of a thead, tbody or tfoot element
+type htmlSection struct {
+	tag  string
+	rows []*html.Node
+}
 
 func (p *HTMLParser) Parse(input []byte) (*TableData, error) {
-	doc, err := html.Parse(bytes.NewReader(input))
+	doc, err := parseHTML(input)
+	if err != nil {
+		return nil, err
+	}
+
+	tables, err := p.selectTables(findTables(doc))
+	if err != nil {
+		return nil, err
+	}
+	if p.Index < 0 || p.Index >= len(tables) {
+		return nil, fmt.Errorf("table index %d out of range (%d tables matched)", p.Index, len(tables))
+	}
+
+	return p.parseTable(tables[p.Index]), nil
+}
+
+// parseHTML decodes the document, honouring a BOM or a charset declared in
+// a meta tag, and parses it
+func parseHTML(input []byte) (*html.Node, error) {
+	enc, bom := detectEncoding(input)
+	if enc == charmap.Windows1252 {
+		// Neither UTF-8 nor UTF-16: look for a charset in a meta tag
+		enc, _, _ = charset.DetermineEncoding(input, "")
+	}
+	input, err := decodeWith(input[len(bom):], enc)
+	if err != nil {
+		return nil, err
+	}
+	return html.Parse(bytes.NewReader(input))
+}
+
+// ParseAll returns every table in the document, ignoring the selection fields
+func (p *HTMLParser) ParseAll(input []byte) ([]*TableData, error) {
+	doc, err := parseHTML(input)
 	if err != nil {
 		return nil, err
 	}
 
-	// Find the first table element
-	table := findFirstTable(doc)
-	if table == nil {
+	tables := findTables(doc)
+	if len(tables) == 0 {
 		return nil, fmt.Errorf("no table found in HTML")
 	}
 
-	var headers []string
-	var rows []map[string]string
+	result := make([]*TableData, len(tables))
+	for i, table := range tables {
+		result[i] = p.parseTable(table)
+	}
+	return result, nil
+}
 
-	// Process table rows
-	var processingHeader bool = true
-	for row := findFirstTag(table, "tr"); row != nil; row = findNextSibling(row, "tr") {
-		if processingHeader {
-			// Process header row
-			for cell := findFirstTag(row, "th"); cell != nil; cell = findNextSibling(cell, "th") {
-				headers = append(headers, getText(cell))
-			}
+// selectTables narrows the tables down to the ones matching the selection fields
+func (p *HTMLParser) selectTables(tables []*html.Node) ([]*html.Node, error) {
+	if len(tables) == 0 {
+		return nil, fmt.Errorf("no table found in HTML")
+	}
 
-			// If no th tags found, try td tags for header
-			if len(headers) == 0 {
-				for cell := findFirstTag(row, "td"); cell != nil; cell = findNextSibling(cell, "td") {
-					headers = append(headers, getText(cell))
-				}
-			}
-			processingHeader = false
+	var selector cascadia.Sel
+	if p.Selector != "" {
+		sel, err := cascadia.Parse(p.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid table selector %q: %v", p.Selector, err)
+		}
+		selector = sel
+	}
+
+	var matched []*html.Node
+	for _, table := range tables {
+		if p.ID != "" && attrValue(table, "id") != p.ID {
+			continue
+		}
+		if p.Class != "" && !hasClass(table, p.Class) {
+			continue
+		}
+		if selector != nil && !selector.Match(table) {
 			continue
 		}
+		if p.Caption != "" && !strings.Contains(strings.ToLower(tableCaption(table)), strings.ToLower(p.Caption)) {
+			continue
+		}
+		matched = append(matched, table)
+	}
 
-		// Process data rows
-		rowData := make(map[string]string)
-		i := 0
-		for cell := findFirstTag(row, "td"); cell != nil; cell = findNextSibling(cell, "td") {
-			if i < len(headers) {
-				rowData[headers[i]] = getText(cell)
-				i++
-			}
+	if len(matched) == 0 {
+		return nil, fmt.Errorf("no table matches the selection")
+	}
+	return matched, nil
+}
+
+func (p *HTMLParser) parseTable(table *html.Node) *TableData {
+	var head, body, foot [][]htmlCell
+	for _, section := range tableSections(table) {
+		grid := expandSpans(section.rows, p.Emphasis)
+		switch section.tag {
+		case "thead":
+			head = append(head, grid...)
+		case "tfoot":
+			foot = append(foot, grid...)
+		default:
+			body = append(body, grid...)
 		}
-		if len(rowData) > 0 {
-			rows = append(rows, rowData)
+	}
+
+	// Without a thead, leading rows made only of th cells form the header,
+	// falling back to the first row like plain tables always did
+	if len(head) == 0 && len(body) > 0 {
+		n := 0
+		for n < len(body) && isHeaderRow(body[n]) {
+			n++
 		}
+		if n == 0 {
+			n = 1
+		}
+		head, body = body[:n], body[n:]
 	}
 
+	width := 0
+	for _, grid := range [][][]htmlCell{head, body, foot} {
+		for _, row := range grid {
+			width = max(width, len(row))
+		}
+	}
+
+	separator := p.HeaderSeparator
+	if separator == "" {
+		separator = " / "
+	}
+	headers := headerLabels(head, width, separator)
+
+	name := tableCaption(table)
+	if name == "" {
+		name = attrValue(table, "id")
+	}
+
+	rows, rich := gridRecords(headers, body)
+	footer, _ := gridRecords(headers, foot)
+
 	return &TableData{
+		Name:    name,
 		Headers: headers,
 		Rows:    rows,
-	}, nil
+		Footer:  footer,
+		Rich:    rich,
+	}
+}
+
+// tableSections returns the row groups of a table in document order.
+// Rows placed directly under the table are treated as an implicit tbody.
+func tableSections(table *html.Node) []htmlSection {
+	var sections []htmlSection
+	for c := table.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type != html.ElementNode {
+			continue
+		}
+		switch c.Data {
+		case "thead", "tbody", "tfoot":
+			section := htmlSection{tag: c.Data}
+			for row := findFirstTag(c, "tr"); row != nil; row = findNextSibling(row, "tr") {
+				section.rows = append(section.rows, row)
+			}
+			sections = append(sections, section)
+		case "tr":
+			if len(sections) == 0 || sections[len(sections)-1].tag != "" {
+				sections = append(sections, htmlSection{})
+			}
+			last := &sections[len(sections)-1]
+			last.rows = append(last.rows, c)
+		}
+	}
+	return sections
+}
+
+// expandSpans lays the cells of a row group out on a rectangular grid,
+// copying cells with colspan or rowspan into every slot they cover
+func expandSpans(rows []*html.Node, emphasis bool) [][]htmlCell {
+	grid := make([][]htmlCell, len(rows))
+	filled := make([][]bool, len(rows))
+
+	for r, tr := range rows {
+		col := 0
+		for c := tr.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type != html.ElementNode || (c.Data != "td" && c.Data != "th") {
+				continue
+			}
+			for col < len(filled[r]) && filled[r][col] {
+				col++
+			}
+
+			colspan := spanAttr(c, "colspan", 1, 1000)
+			if colspan == 0 {
+				colspan = 1
+			}
+			// rowspan="0" extends the cell to the end of the row group
+			rowspan := spanAttr(c, "rowspan", 1, 65534)
+			if rowspan == 0 || r+rowspan > len(rows) {
+				rowspan = len(rows) - r
+			}
+
+			rich := cellContent(c, emphasis)
+			cell := htmlCell{text: rich.String(), header: c.Data == "th"}
+			if rich.Formatted() {
+				cell.rich = rich
+			}
+			for i := r; i < r+rowspan; i++ {
+				for j := col; j < col+colspan; j++ {
+					for len(grid[i]) <= j {
+						grid[i] = append(grid[i], htmlCell{})
+						filled[i] = append(filled[i], false)
+					}
+					grid[i][j] = cell
+					filled[i][j] = true
+				}
+			}
+			col += colspan
+		}
+	}
+	return grid
+}
+
+func spanAttr(n *html.Node, name string, def, limit int) int {
+	for _, attr := range n.Attr {
+		if attr.Key != name {
+			continue
+		}
+		v, err := strconv.Atoi(strings.TrimSpace(attr.Val))
+		if err != nil || v < 0 {
+			return def
+		}
+		return min(v, limit)
+	}
+	return def
+}
+
+func isHeaderRow(row []htmlCell) bool {
+	if len(row) == 0 {
+		return false
+	}
+	for _, cell := range row {
+		if !cell.header {
+			return false
+		}
+	}
+	return true
+}
+
+// headerLabels builds one column name per grid column, joining the distinct
+// labels found in each header row
+func headerLabels(head [][]htmlCell, width int, separator string) []string {
+	headers := make([]string, width)
+	for col := 0; col < width; col++ {
+		var parts []string
+		for _, row := range head {
+			if col >= len(row) {
+				continue
+			}
+			text := row[col].text
+			if text == "" || (len(parts) > 0 && parts[len(parts)-1] == text) {
+				continue
+			}
+			parts = append(parts, text)
+		}
+		headers[col] = strings.Join(parts, separator)
+	}
+	return uniqueHeaders(headers)
+}
+
+// gridRecords converts grid rows into records keyed by header, along with the
+// rich content of formatted cells. The rich slice is nil when no cell needs it.
+func gridRecords(headers []string, grid [][]htmlCell) ([]map[string]string, []map[string]RichText) {
+	var rows []map[string]string
+	var rich []map[string]RichText
+	formatted := false
+	for _, cells := range grid {
+		if len(cells) == 0 {
+			continue
+		}
+		rowData := make(map[string]string)
+		var rowRich map[string]RichText
+		for i, cell := range cells {
+			rowData[headers[i]] = cell.text
+			if cell.rich != nil {
+				if rowRich == nil {
+					rowRich = make(map[string]RichText)
+				}
+				rowRich[headers[i]] = cell.rich
+				formatted = true
+			}
+		}
+		rows = append(rows, rowData)
+		rich = append(rich, rowRich)
+	}
+	if !formatted {
+		rich = nil
+	}
+	return rows, rich
 }
 
 // Helper functions for HTML parsing
-func findFirstTable(n *html.Node) *html.Node {
+func findTables(n *html.Node) []*html.Node {
+	var tables []*html.Node
 	if n.Type == html.ElementNode && n.Data == "table" {
-		return n
+		tables = append(tables, n)
 	}
 	for c := n.FirstChild; c != nil; c = c.NextSibling {
-		if result := findFirstTable(c); result != nil {
-			return result
+		tables = append(tables, findTables(c)...)
+	}
+	return tables
+}
+
+func tableCaption(table *html.Node) string {
+	if caption := findFirstTag(table, "caption"); caption != nil {
+		return getText(caption)
+	}
+	return ""
+}
+
+func attrValue(n *html.Node, key string) string {
+	for _, attr := range n.Attr {
+		if attr.Key == key {
+			return attr.Val
 		}
 	}
-	return nil
+	return ""
+}
+
+func hasClass(n *html.Node, class string) bool {
+	for _, c := range strings.Fields(attrValue(n, "class")) {
+		if c == class {
+			return true
+		}
+	}
+	return false
 }
 
 func findFirstTag(n *html.Node, tag string) *html.Node {
@@ -96,16 +393,118 @@ func findNextSibling(n *html.Node, tag string) *html.Node {
 }
 
 func getText(n *html.Node) string {
-	var text strings.Builder
-	var extract func(*html.Node)
-	extract = func(n *html.Node) {
-		if n.Type == html.TextNode {
-			text.WriteString(n.Data)
+	return cellContent(n, false).String()
+}
+
+// cellContent extracts the text of a node with HTML whitespace collapsing,
+// keeping links and <br> line breaks and, if emphasis is set, bold, italic
+// and code formatting
+func cellContent(n *html.Node, emphasis bool) RichText {
+	var rt RichText
+
+	add := func(span Span) {
+		if len(rt) > 0 && rt[len(rt)-1].sameFormat(span) {
+			rt[len(rt)-1].Text += span.Text
+		} else {
+			rt = append(rt, span)
+		}
+	}
+	addText := func(text string, format Span) {
+		text = collapseSpace(text)
+		if strings.HasPrefix(text, " ") && (len(rt) == 0 || endsWithSpace(rt)) {
+			text = text[1:]
+		}
+		if text == "" {
+			return
+		}
+		format.Text = text
+		add(format)
+	}
+	lineBreak := func() {
+		if len(rt) > 0 {
+			last := &rt[len(rt)-1]
+			last.Text = strings.TrimRight(last.Text, " ")
+			if last.Text == "" {
+				rt = rt[:len(rt)-1]
+			}
+		}
+		add(Span{Text: "\n"})
+	}
+
+	var walk func(n *html.Node, format Span)
+	walk = func(n *html.Node, format Span) {
+		switch n.Type {
+		case html.TextNode:
+			addText(n.Data, format)
+			return
+		case html.ElementNode:
+			switch n.Data {
+			case "br":
+				lineBreak()
+				return
+			case "script", "style":
+				return
+			case "a":
+				if href := attrValue(n, "href"); href != "" {
+					format.Link = href
+				}
+			case "b", "strong":
+				format.Bold = format.Bold || emphasis
+			case "i", "em":
+				format.Italic = format.Italic || emphasis
+			case "code", "kbd", "samp", "tt":
+				format.Code = format.Code || emphasis
+			}
 		}
 		for c := n.FirstChild; c != nil; c = c.NextSibling {
-			extract(c)
+			walk(c, format)
+		}
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		walk(c, Span{})
+	}
+
+	// Trim surrounding whitespace of the whole cell
+	for len(rt) > 0 {
+		rt[0].Text = strings.TrimLeft(rt[0].Text, " \n")
+		if rt[0].Text != "" {
+			break
+		}
+		rt = rt[1:]
+	}
+	for len(rt) > 0 {
+		last := &rt[len(rt)-1]
+		last.Text = strings.TrimRight(last.Text, " \n")
+		if last.Text != "" {
+			break
 		}
+		rt = rt[:len(rt)-1]
 	}
-	extract(n)
-	return strings.TrimSpace(text.String())
+	return rt
+}
+
+// collapseSpace replaces every run of HTML whitespace with a single space
+func collapseSpace(text string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range text {
+		if r == ' ' || r == '\t' || r == '\n' || r == '\r' || r == '\f' {
+			space = true
+			continue
+		}
+		if space {
+			b.WriteByte(' ')
+			space = false
+		}
+		b.WriteRune(r)
+	}
+	if space {
+		b.WriteByte(' ')
+	}
+	return b.String()
+}
+
+func endsWithSpace(rt RichText) bool {
+	last := rt[len(rt)-1].Text
+	return strings.HasSuffix(last, " ") || strings.HasSuffix(last, "\n")
 }
@@ -0,0 +1,207 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHTMLParser_Parse(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    *TableData
+		wantErr bool
+	}{
+		{
+			name: "Plain Table",
+			input: `<table>
+				<tr><th>Name</th><th>Age</th></tr>
+				<tr><td>John</td><td>30</td></tr>
+			</table>`,
+			want: &TableData{
+				Headers: []string{"Name", "Age"},
+				Rows: []map[string]string{
+					{"Name": "John", "Age": "30"},
+				},
+			},
+		},
+		{
+			name: "Sections With Footer",
+			input: `<table>
+				<thead><tr><th>Item</th><th>Price</th></tr></thead>
+				<tfoot><tr><td>Total</td><td>5</td></tr></tfoot>
+				<tbody>
+					<tr><td>Tea</td><td>2</td></tr>
+					<tr><td>Cake</td><td>3</td></tr>
+				</tbody>
+			</table>`,
+			want: &TableData{
+				Headers: []string{"Item", "Price"},
+				Rows: []map[string]string{
+					{"Item": "Tea", "Price": "2"},
+					{"Item": "Cake", "Price": "3"},
+				},
+				Footer: []map[string]string{
+					{"Item": "Total", "Price": "5"},
+				},
+			},
+		},
+		{
+			name: "Colspan And Rowspan",
+			input: `<table>
+				<tr><th>Region</th><th>City</th><th>Sales</th></tr>
+				<tr><td rowspan="2">North</td><td>Oslo</td><td>10</td></tr>
+				<tr><td>Bergen</td><td>7</td></tr>
+				<tr><td colspan="2">Unknown</td><td>1</td></tr>
+			</table>`,
+			want: &TableData{
+				Headers: []string{"Region", "City", "Sales"},
+				Rows: []map[string]string{
+					{"Region": "North", "City": "Oslo", "Sales": "10"},
+					{"Region": "North", "City": "Bergen", "Sales": "7"},
+					{"Region": "Unknown", "City": "Unknown", "Sales": "1"},
+				},
+			},
+		},
+		{
+			name: "Multi-Row Header",
+			input: `<table>
+				<thead>
+					<tr><th rowspan="2">ID</th><th colspan="2">Name</th></tr>
+					<tr><th>First</th><th>Last</th></tr>
+				</thead>
+				<tbody><tr><td>1</td><td>Ada</td><td>Lovelace</td></tr></tbody>
+			</table>`,
+			want: &TableData{
+				Headers: []string{"ID", "Name / First", "Name / Last"},
+				Rows: []map[string]string{
+					{"ID": "1", "Name / First": "Ada", "Name / Last": "Lovelace"},
+				},
+			},
+		},
+		{
+			name:    "No Table",
+			input:   `<p>nothing here</p>`,
+			wantErr: true,
+		},
+	}
+
+	parser := &HTMLParser{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parser.Parse([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HTMLParser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("HTMLParser.Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTMLParser_SelectTable(t *testing.T) {
+	page := `<html><body>
+		<table class="layout"><tr><td>menu</td></tr></table>
+		<table id="prices" class="data">
+			<caption>Price List</caption>
+			<tr><th>Item</th></tr><tr><td>Tea</td></tr>
+		</table>
+		<table class="data">
+			<caption>Stock</caption>
+			<tr><th>Item</th></tr><tr><td>Cake</td></tr>
+		</table>
+	</body></html>`
+
+	tests := []struct {
+		name     string
+		parser   *HTMLParser
+		wantName string
+		wantErr  bool
+	}{
+		{"Default First", &HTMLParser{}, "", false},
+		{"By Index", &HTMLParser{Index: 2}, "Stock", false},
+		{"By ID", &HTMLParser{ID: "prices"}, "Price List", false},
+		{"By Class And Index", &HTMLParser{Class: "data", Index: 1}, "Stock", false},
+		{"By Selector", &HTMLParser{Selector: "table.data:not(#prices)"}, "Stock", false},
+		{"By Caption", &HTMLParser{Caption: "price"}, "Price List", false},
+		{"No Match", &HTMLParser{ID: "missing"}, "", true},
+		{"Index Out Of Range", &HTMLParser{Index: 5}, "", true},
+		{"Invalid Selector", &HTMLParser{Selector: "table["}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parser.Parse([]byte(page))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HTMLParser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got.Name != tt.wantName {
+				t.Errorf("HTMLParser.Parse() table = %q, want %q", got.Name, tt.wantName)
+			}
+		})
+	}
+
+	all, err := (&HTMLParser{}).ParseAll([]byte(page))
+	if err != nil {
+		t.Fatalf("HTMLParser.ParseAll() error = %v", err)
+	}
+	if len(all) != 3 {
+		t.Fatalf("HTMLParser.ParseAll() returned %d tables, want 3", len(all))
+	}
+	if all[1].Name != "Price List" || all[1].Rows[0]["Item"] != "Tea" {
+		t.Errorf("HTMLParser.ParseAll()[1] = %v, want the Price List table", all[1])
+	}
+}
+
+func TestHTMLParser_RichCells(t *testing.T) {
+	input := `<table>
+		<tr><th>Name</th><th>Notes</th></tr>
+		<tr>
+			<td><a href="https://example.com/ada">Ada
+				Lovelace</a></td>
+			<td>First <b>programmer</b><br> wrote <code>notes</code></td>
+		</tr>
+		<tr><td>Plain</td><td>text</td></tr>
+	</table>`
+
+	got, err := (&HTMLParser{Emphasis: true}).Parse([]byte(input))
+	if err != nil {
+		t.Fatalf("HTMLParser.Parse() error = %v", err)
+	}
+
+	wantRows := []map[string]string{
+		{"Name": "Ada Lovelace", "Notes": "First programmer\nwrote notes"},
+		{"Name": "Plain", "Notes": "text"},
+	}
+	if !reflect.DeepEqual(got.Rows, wantRows) {
+		t.Errorf("Rows = %q, want %q", got.Rows, wantRows)
+	}
+
+	wantRich := []map[string]RichText{
+		{
+			"Name": {{Text: "Ada Lovelace", Link: "https://example.com/ada"}},
+			"Notes": {
+				{Text: "First "},
+				{Text: "programmer", Bold: true},
+				{Text: "\nwrote "},
+				{Text: "notes", Code: true},
+			},
+		},
+		nil,
+	}
+	if !reflect.DeepEqual(got.Rich, wantRich) {
+		t.Errorf("Rich = %v, want %v", got.Rich, wantRich)
+	}
+
+	// Without emphasis only the link and the line break are kept
+	got, err = (&HTMLParser{}).Parse([]byte(input))
+	if err != nil {
+		t.Fatalf("HTMLParser.Parse() error = %v", err)
+	}
+	if notes, _ := got.RichCell(0, "Notes"); !reflect.DeepEqual(notes, RichText{{Text: "First programmer\nwrote notes"}}) {
+		t.Errorf("Rich Notes = %v, want plain spans", notes)
+	}
+}
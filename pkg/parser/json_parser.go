@@ -0,0 +1,86 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// JSONParser implements Parser for JSON input. Every object in the record
+// array is a record and the headers are the union of their flattened keys.
+type JSONParser struct {
+	// Path locates the records inside an envelope, as a JSONPath subset
+	// ($.data.items[*]) or jq-like expression (.data.items[])
+	Path string
+	// KeyColumn names the column holding the keys of an object of objects,
+	// "key" by default
+	KeyColumn string
+	// HeaderRow treats the first object as a header row and skips it as data,
+	// the convention older versions of gotable used
+	HeaderRow bool
+	FlattenOptions
+}
+
+func (p *JSONParser) Parse(input []byte) (*TableData, error) {
+	input, err := Decode(input, "")
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := parseJSONDocument(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Path != "" {
+		if value, err = evalPath(value, p.Path); err != nil {
+			return nil, err
+		}
+	}
+
+	records, err := tableRecords(value, p.KeyColumn)
+	if err != nil {
+		return nil, fmt.Errorf("JSON input: %v", err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("empty JSON array")
+	}
+
+	if !p.HeaderRow {
+		return recordTable(records, p.FlattenOptions)
+	}
+
+	// Legacy layout: the keys of the first object order the headers. The
+	// columns flattened from a key's nested values take its place.
+	header, ok := records[0].(*object)
+	if !ok {
+		return nil, fmt.Errorf("JSON header row must be an object")
+	}
+	data, err := recordTable(records[1:], p.FlattenOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	var headers []string
+	placed := make(map[string]bool)
+	for _, key := range header.keys {
+		found := false
+		for _, h := range data.Headers {
+			if !placed[h] && (h == key || strings.HasPrefix(h, key+p.separator())) {
+				headers = append(headers, h)
+				placed[h] = true
+				found = true
+			}
+		}
+		if !found && !placed[key] {
+			headers = append(headers, key)
+			placed[key] = true
+		}
+	}
+	for _, h := range data.Headers {
+		if !placed[h] {
+			headers = append(headers, h)
+		}
+	}
+	data.Headers = headers
+	return data, nil
+}
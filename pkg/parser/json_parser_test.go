@@ -8,12 +8,51 @@ import (
 func TestJSONParser_Parse(t *testing.T) {
 	tests := []struct {
 		name    string
+		parser  *JSONParser
 		input   string
 		want    *TableData
 		wantErr bool
 	}{
 		{
-			name: "Valid JSON",
+			name:   "Valid JSON",
+			parser: &JSONParser{},
+			input: `[
+				{"name": "John", "age": "30"},
+				{"name": "Alice", "age": "25"}
+			]`,
+			want: &TableData{
+				Headers: []string{"name", "age"},
+				Rows: []map[string]string{
+					{"name": "John", "age": "30"},
+					{"name": "Alice", "age": "25"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:   "Union Of Keys And Types",
+			parser: &JSONParser{},
+			input: `[
+				{"id": 1, "name": "John", "score": 9.5},
+				{"id": 2, "active": true, "score": 7, "name": null}
+			]`,
+			want: &TableData{
+				Headers: []string{"id", "name", "score", "active"},
+				Rows: []map[string]string{
+					{"id": "1", "name": "John", "score": "9.5"},
+					{"id": "2", "active": "true", "score": "7"},
+				},
+				Types: map[string]ColumnType{
+					"id":     TypeInteger,
+					"score":  TypeFloat,
+					"active": TypeBoolean,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:   "Legacy Header Row",
+			parser: &JSONParser{HeaderRow: true},
 			input: `[
 				{"name": "Name", "age": "Age"},
 				{"name": "John", "age": "30"},
@@ -28,24 +67,50 @@ func TestJSONParser_Parse(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:   "Legacy Header Row With Nested Values",
+			parser: &JSONParser{HeaderRow: true},
+			input: `[
+				{"name": "Name", "address": "Address", "note": "Note"},
+				{"address": {"city": "Oslo", "zip": "0150"}, "name": "John", "age": 30},
+				{"name": "Alice", "address": {"city": "Rome"}}
+			]`,
+			want: &TableData{
+				Headers: []string{"name", "address.city", "address.zip", "note", "age"},
+				Rows: []map[string]string{
+					{"name": "John", "address.city": "Oslo", "address.zip": "0150", "age": "30"},
+					{"name": "Alice", "address.city": "Rome"},
+				},
+				Types: map[string]ColumnType{"age": TypeInteger},
+			},
+			wantErr: false,
+		},
 		{
 			name:    "Invalid JSON",
+			parser:  &JSONParser{},
 			input:   `invalid json`,
 			want:    nil,
 			wantErr: true,
 		},
 		{
 			name:    "Empty JSON Array",
+			parser:  &JSONParser{},
 			input:   `[]`,
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name:    "Not An Array",
+			parser:  &JSONParser{},
+			input:   `{"name": "John"}`,
+			want:    nil,
+			wantErr: true,
+		},
 	}
 
-	parser := &JSONParser{}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := parser.Parse([]byte(tt.input))
+			got, err := tt.parser.Parse([]byte(tt.input))
 			if (err != nil) != tt.wantErr {
 				t.Errorf("JSONParser.Parse() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -56,3 +121,195 @@ func TestJSONParser_Parse(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONParser_Flatten(t *testing.T) {
+	input := `[
+		{"id": 1, "user": {"name": "Ann", "address": {"city": "Oslo"}}, "tags": ["a", "b"]},
+		{"id": 2, "user": {"name": "Bob"}, "tags": []}
+	]`
+
+	tests := []struct {
+		name   string
+		parser *JSONParser
+		want   *TableData
+	}{
+		{
+			name:   "Indexed Arrays",
+			parser: &JSONParser{},
+			want: &TableData{
+				Headers: []string{"id", "user.name", "user.address.city", "tags.0", "tags.1"},
+				Rows: []map[string]string{
+					{"id": "1", "user.name": "Ann", "user.address.city": "Oslo", "tags.0": "a", "tags.1": "b"},
+					{"id": "2", "user.name": "Bob"},
+				},
+				Types: map[string]ColumnType{"id": TypeInteger},
+			},
+		},
+		{
+			name:   "Joined Arrays",
+			parser: &JSONParser{FlattenOptions: FlattenOptions{Arrays: ArrayJoin, Separator: "_"}},
+			want: &TableData{
+				Headers: []string{"id", "user_name", "user_address_city", "tags"},
+				Rows: []map[string]string{
+					{"id": "1", "user_name": "Ann", "user_address_city": "Oslo", "tags": "a, b"},
+					{"id": "2", "user_name": "Bob", "tags": ""},
+				},
+				Types: map[string]ColumnType{"id": TypeInteger},
+			},
+		},
+		{
+			name:   "JSON Arrays",
+			parser: &JSONParser{FlattenOptions: FlattenOptions{Arrays: ArrayJSON}},
+			want: &TableData{
+				Headers: []string{"id", "user.name", "user.address.city", "tags"},
+				Rows: []map[string]string{
+					{"id": "1", "user.name": "Ann", "user.address.city": "Oslo", "tags": `["a","b"]`},
+					{"id": "2", "user.name": "Bob", "tags": "[]"},
+				},
+				Types: map[string]ColumnType{"id": TypeInteger},
+			},
+		},
+		{
+			name:   "Explode",
+			parser: &JSONParser{FlattenOptions: FlattenOptions{Explode: "tags"}},
+			want: &TableData{
+				Headers: []string{"id", "user.name", "user.address.city", "tags"},
+				Rows: []map[string]string{
+					{"id": "1", "user.name": "Ann", "user.address.city": "Oslo", "tags": "a"},
+					{"id": "1", "user.name": "Ann", "user.address.city": "Oslo", "tags": "b"},
+					{"id": "2", "user.name": "Bob"},
+				},
+				Types: map[string]ColumnType{"id": TypeInteger},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parser.Parse([]byte(input))
+			if err != nil {
+				t.Fatalf("JSONParser.Parse() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("JSONParser.Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONParser_ExplodeObjects(t *testing.T) {
+	input := `[{"order": 7, "lines": [{"sku": "A", "qty": 1}, {"sku": "B", "qty": 2}]}]`
+
+	got, err := (&JSONParser{FlattenOptions: FlattenOptions{Explode: "lines"}}).Parse([]byte(input))
+	if err != nil {
+		t.Fatalf("JSONParser.Parse() error = %v", err)
+	}
+	want := []map[string]string{
+		{"order": "7", "lines.sku": "A", "lines.qty": "1"},
+		{"order": "7", "lines.sku": "B", "lines.qty": "2"},
+	}
+	if !reflect.DeepEqual(got.Rows, want) {
+		t.Errorf("JSONParser.Parse() rows = %v, want %v", got.Rows, want)
+	}
+}
+
+func TestParseArrayMode(t *testing.T) {
+	for name, want := range map[string]ArrayMode{"": ArrayIndex, "index": ArrayIndex, "Join": ArrayJoin, "json": ArrayJSON} {
+		if got, err := ParseArrayMode(name); err != nil || got != want {
+			t.Errorf("ParseArrayMode(%q) = %q, %v, want %q", name, got, err, want)
+		}
+	}
+	if _, err := ParseArrayMode("indx"); err == nil {
+		t.Error("ParseArrayMode() expected an error for an unknown mode")
+	}
+}
+
+func TestJSONParser_Path(t *testing.T) {
+	envelope := `{"status": "ok", "data": {"items": [{"id": 1}, {"id": 2}], "odd key]": [{"id": 3}]}}`
+
+	tests := []struct {
+		name    string
+		parser  *JSONParser
+		input   string
+		want    []map[string]string
+		headers []string
+		wantErr bool
+	}{
+		{
+			name:    "JSONPath",
+			parser:  &JSONParser{Path: "$.data.items[*]"},
+			input:   envelope,
+			headers: []string{"id"},
+			want:    []map[string]string{{"id": "1"}, {"id": "2"}},
+		},
+		{
+			name:    "JQ Style",
+			parser:  &JSONParser{Path: ".data.items"},
+			input:   envelope,
+			headers: []string{"id"},
+			want:    []map[string]string{{"id": "1"}, {"id": "2"}},
+		},
+		{
+			name:    "Quoted Name",
+			parser:  &JSONParser{Path: `$['data']["odd key]"]`},
+			input:   envelope,
+			headers: []string{"id"},
+			want:    []map[string]string{{"id": "3"}},
+		},
+		{
+			name:    "Index",
+			parser:  &JSONParser{Path: ".pages[-1].rows"},
+			input:   `{"pages": [{"rows": [{"n": 1}]}, {"rows": [{"n": 2}]}]}`,
+			headers: []string{"n"},
+			want:    []map[string]string{{"n": "2"}},
+		},
+		{
+			name:    "Keyed Objects",
+			parser:  &JSONParser{Path: ".users", KeyColumn: "login"},
+			input:   `{"users": {"ann": {"age": 30}, "bob": {"age": 41}}}`,
+			headers: []string{"login", "age"},
+			want:    []map[string]string{{"login": "ann", "age": "30"}, {"login": "bob", "age": "41"}},
+		},
+		{
+			name:    "Column Oriented",
+			parser:  &JSONParser{},
+			input:   `{"name": ["Ann", "Bob"], "age": [30, 41]}`,
+			headers: []string{"name", "age"},
+			want:    []map[string]string{{"name": "Ann", "age": "30"}, {"name": "Bob", "age": "41"}},
+		},
+		{
+			name:    "Envelope Without Path",
+			parser:  &JSONParser{},
+			input:   envelope,
+			wantErr: true,
+		},
+		{
+			name:    "Path Matches Nothing",
+			parser:  &JSONParser{Path: "$.data.missing"},
+			input:   envelope,
+			wantErr: true,
+		},
+		{
+			name:    "Invalid Path",
+			parser:  &JSONParser{Path: "$.data[x]"},
+			input:   envelope,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parser.Parse([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("JSONParser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got.Headers, tt.headers) || !reflect.DeepEqual(got.Rows, tt.want) {
+				t.Errorf("JSONParser.Parse() = %v %v, want %v %v", got.Headers, got.Rows, tt.headers, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// pathStep is one step of a path expression: a field name, an array index or
+// a wildcard over every item
+type pathStep struct {
+	field    string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// parsePath reads a JSONPath subset ($.data.items[*], $['a b'][0]) or the
+// equivalent jq-like form (.data.items[], .["a b"][0])
+func parsePath(path string) ([]pathStep, error) {
+	rest := strings.TrimSpace(path)
+	rest = strings.TrimPrefix(rest, "$")
+
+	var steps []pathStep
+	for rest != "" {
+		switch {
+		case strings.HasPrefix(rest, "."):
+			rest = rest[1:]
+			if rest == "" || rest[0] == '[' {
+				continue
+			}
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			name := rest[:end]
+			rest = rest[end:]
+			if name == "*" {
+				steps = append(steps, pathStep{wildcard: true})
+			} else {
+				steps = append(steps, pathStep{field: name})
+			}
+		case strings.HasPrefix(rest, "["):
+			body := rest[1:]
+			// Quoted names may contain ] so they are read up to the closing quote
+			if body != "" && (body[0] == '\'' || body[0] == '"') {
+				closing := strings.IndexByte(body[1:], body[0])
+				if closing < 0 {
+					return nil, fmt.Errorf("invalid path %q: unterminated quote", path)
+				}
+				name := body[1 : 1+closing]
+				after := body[2+closing:]
+				if !strings.HasPrefix(after, "]") {
+					return nil, fmt.Errorf("invalid path %q: missing ]", path)
+				}
+				steps = append(steps, pathStep{field: name})
+				rest = after[1:]
+				continue
+			}
+			end := strings.IndexByte(body, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path %q: missing ]", path)
+			}
+			inner := strings.TrimSpace(body[:end])
+			rest = body[end+1:]
+			switch inner {
+			case "", "*":
+				steps = append(steps, pathStep{wildcard: true})
+			default:
+				index, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, fmt.Errorf("invalid path %q: bad index %q", path, inner)
+				}
+				steps = append(steps, pathStep{index: index, isIndex: true})
+			}
+		default:
+			return nil, fmt.Errorf("invalid path %q: expected . or [ at %q", path, rest)
+		}
+	}
+	return steps, nil
+}
+
+// evalPath applies a path to a decoded document. A path ending in a
+// wildcard returns the matched items, otherwise the single matched value.
+func evalPath(value any, path string) (any, error) {
+	steps, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	current := []any{value}
+	for _, step := range steps {
+		var next []any
+		for _, v := range current {
+			switch {
+			case step.wildcard:
+				switch v := v.(type) {
+				case []any:
+					next = append(next, v...)
+				case *object:
+					for _, key := range v.keys {
+						next = append(next, v.values[key])
+					}
+				}
+			case step.isIndex:
+				if list, ok := v.([]any); ok {
+					index := step.index
+					if index < 0 {
+						index += len(list)
+					}
+					if index >= 0 && index < len(list) {
+						next = append(next, list[index])
+					}
+				}
+			default:
+				if obj, ok := v.(*object); ok {
+					if child, ok := obj.values[step.field]; ok {
+						next = append(next, child)
+					}
+				}
+			}
+		}
+		current = next
+	}
+
+	if len(steps) > 0 && steps[len(steps)-1].wildcard {
+		return current, nil
+	}
+	if len(current) == 0 {
+		return nil, fmt.Errorf("path %q matched nothing", path)
+	}
+	if len(current) > 1 {
+		return current, nil
+	}
+	return current[0], nil
+}
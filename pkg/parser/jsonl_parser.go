@@ -0,0 +1,62 @@
+package parser
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+)
+
+// JSONLParser implements Parser for JSON Lines (NDJSON) input, where every
+// non-blank line holds one JSON record
+type JSONLParser struct {
+	// SkipInvalid drops lines that are not valid JSON instead of failing
+	SkipInvalid bool
+	FlattenOptions
+}
+
+func (p *JSONLParser) Parse(input []byte) (*TableData, error) {
+	input, err := Decode(input, "")
+	if err != nil {
+		return nil, err
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(input))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(input)+1)
+
+	var records []any
+	var warnings []Warning
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		record, err := parseJSONDocument(text)
+		if _, nested := record.([]any); nested && err == nil {
+			err = fmt.Errorf("record is an array, not an object")
+		}
+		if err != nil {
+			if p.SkipInvalid {
+				warnings = append(warnings, Warning{Line: line, Message: fmt.Sprintf("skipped invalid record: %v", err)})
+				continue
+			}
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, fmt.Errorf("no JSON records found")
+	}
+	data, err := recordTable(records, p.FlattenOptions)
+	if err != nil {
+		return nil, err
+	}
+	data.Warnings = warnings
+	return data, nil
+}
@@ -0,0 +1,100 @@
+package parser
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestJSONLParser_Parse(t *testing.T) {
+	tests := []struct {
+		name    string
+		parser  *JSONLParser
+		input   string
+		want    *TableData
+		wantErr string
+	}{
+		{
+			name:   "Records With Blank Lines",
+			parser: &JSONLParser{},
+			input:  "{\"level\":\"info\",\"msg\":\"start\"}\n\n{\"level\":\"warn\",\"msg\":\"slow\",\"ms\":250}\r\n",
+			want: &TableData{
+				Headers: []string{"level", "msg", "ms"},
+				Rows: []map[string]string{
+					{"level": "info", "msg": "start"},
+					{"level": "warn", "msg": "slow", "ms": "250"},
+				},
+				Types: map[string]ColumnType{"ms": TypeInteger},
+			},
+		},
+		{
+			name:   "Skip Invalid Lines",
+			parser: &JSONLParser{SkipInvalid: true},
+			input:  "{\"a\":1}\nnot json\n{\"a\":2}\n",
+			want: &TableData{
+				Headers: []string{"a"},
+				Rows: []map[string]string{
+					{"a": "1"},
+					{"a": "2"},
+				},
+				Types: map[string]ColumnType{"a": TypeInteger},
+				Warnings: []Warning{
+					{Line: 2, Message: "skipped invalid record: invalid character 'o' in literal null (expecting 'u')"},
+				},
+			},
+		},
+		{
+			name:    "Invalid Line",
+			parser:  &JSONLParser{},
+			input:   "{\"a\":1}\n\n{\"a\":\n",
+			wantErr: "line 3",
+		},
+		{
+			name:   "Skip Array Records",
+			parser: &JSONLParser{SkipInvalid: true},
+			input:  "{\"a\":1}\n\nbad\n[1,2]\n{\"a\":2}\n",
+			want: &TableData{
+				Headers: []string{"a"},
+				Rows: []map[string]string{
+					{"a": "1"},
+					{"a": "2"},
+				},
+				Types: map[string]ColumnType{"a": TypeInteger},
+				Warnings: []Warning{
+					{Line: 3, Message: "skipped invalid record: invalid character 'b' looking for beginning of value"},
+					{Line: 4, Message: "skipped invalid record: record is an array, not an object"},
+				},
+			},
+		},
+		{
+			name:    "Array Record",
+			parser:  &JSONLParser{},
+			input:   "{\"a\":1}\n\n[1]\n",
+			wantErr: "line 3: record is an array",
+		},
+		{
+			name:    "Empty Input",
+			parser:  &JSONLParser{},
+			input:   "\n\n",
+			wantErr: "no JSON records",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parser.Parse([]byte(tt.input))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("JSONLParser.Parse() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("JSONLParser.Parse() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("JSONLParser.Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
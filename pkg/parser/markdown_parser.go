@@ -0,0 +1,256 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MarkdownParser implements Parser for GitHub Flavored Markdown pipe tables.
+// Tables inside fenced code blocks are ignored, and each table is named
+// after the heading above it.
+type MarkdownParser struct {
+	// Index picks the table when the document holds several, counting from 0
+	Index int
+}
+
+var (
+	atxHeading    = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))?(?:[ \t]+#+)?[ \t]*$`)
+	setextLine    = regexp.MustCompile(`^ {0,3}(=+|-+)[ \t]*$`)
+	codeFence     = regexp.MustCompile("^ {0,3}(`{3,}|~{3,})")
+	delimiterCell = regexp.MustCompile(`^:?-+:?$`)
+	lineBreak     = regexp.MustCompile(`(?i)<br\s*/?>`)
+	lineBreakTag  = regexp.MustCompile(`(?i)^<br\s*/?>`)
+)
+
+func (p *MarkdownParser) Parse(input []byte) (*TableData, error) {
+	tables, err := p.ParseAll(input)
+	if err != nil {
+		return nil, err
+	}
+	if p.Index < 0 || p.Index >= len(tables) {
+		return nil, fmt.Errorf("table index %d out of range (%d tables found)", p.Index, len(tables))
+	}
+	return tables[p.Index], nil
+}
+
+// ParseAll returns every table in the document, ignoring Index
+func (p *MarkdownParser) ParseAll(input []byte) ([]*TableData, error) {
+	input, err := Decode(input, "")
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(strings.ReplaceAll(string(input), "\r\n", "\n"), "\n")
+
+	var tables []*TableData
+	heading := ""
+	fence := ""
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+
+		// Skip the contents of fenced code blocks
+		if fence != "" {
+			// The closing fence is at least as long as the opening one
+			if closing := strings.TrimSpace(line); strings.HasPrefix(closing, fence) && strings.Trim(closing, fence[:1]) == "" {
+				fence = ""
+			}
+			continue
+		}
+		if m := codeFence.FindStringSubmatch(line); m != nil {
+			fence = m[1]
+			continue
+		}
+
+		if m := atxHeading.FindStringSubmatch(line); m != nil {
+			heading = strings.TrimSpace(m[2])
+			continue
+		}
+		if i+1 >= len(lines) || strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		align, ok := delimiterRow(lines[i+1])
+		headers := splitTableRow(line)
+		if !ok || len(align) != len(headers) {
+			if setextLine.MatchString(lines[i+1]) {
+				heading = strings.TrimSpace(line)
+				i++
+			}
+			continue
+		}
+
+		table, end := readMarkdownTable(lines, i, headers, align)
+		table.Name = heading
+		tables = append(tables, table)
+		i = end - 1
+	}
+
+	if len(tables) == 0 {
+		return nil, fmt.Errorf("no table found in Markdown")
+	}
+	return tables, nil
+}
+
+// readMarkdownTable reads the body of the table whose header starts at
+// lines[start], returning the table and the index of the line after it
+func readMarkdownTable(lines []string, start int, cells []string, align []Alignment) (*TableData, int) {
+	headers := make([]string, len(cells))
+	for i, cell := range cells {
+		headers[i] = markdownCell(cell)
+	}
+	headers = uniqueHeaders(headers)
+
+	table := &TableData{Headers: headers}
+	for i, a := range align {
+		if a == "" {
+			continue
+		}
+		if table.Align == nil {
+			table.Align = make(map[string]Alignment)
+		}
+		table.Align[headers[i]] = a
+	}
+
+	end := start + 2
+	for ; end < len(lines); end++ {
+		line := lines[end]
+		if strings.TrimSpace(line) == "" || endsTable(line) {
+			break
+		}
+
+		cells := splitTableRow(line)
+		if len(cells) > len(headers) {
+			table.Warnings = append(table.Warnings, Warning{
+				Line:    end + 1,
+				Message: fmt.Sprintf("%d cells, expected %d; surplus cells dropped", len(cells), len(headers)),
+			})
+		}
+
+		// Missing cells are empty, as in GitHub's renderer
+		row := make(map[string]string, len(headers))
+		for i, h := range headers {
+			if i < len(cells) {
+				row[h] = markdownCell(cells[i])
+			} else {
+				row[h] = ""
+			}
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table, end
+}
+
+// endsTable reports whether a line starts a block that interrupts a table
+func endsTable(line string) bool {
+	trimmed := strings.TrimLeft(line, " ")
+	return len(line)-len(trimmed) < 4 &&
+		(strings.HasPrefix(trimmed, ">") || atxHeading.MatchString(line) || codeFence.MatchString(line))
+}
+
+// delimiterRow parses the row under the header, such as | :-- | --: |. It
+// must contain a pipe, or a lone --- would be taken for a table.
+func delimiterRow(line string) ([]Alignment, bool) {
+	if !strings.Contains(line, "|") {
+		return nil, false
+	}
+
+	cells := splitTableRow(line)
+	align := make([]Alignment, len(cells))
+	for i, cell := range cells {
+		cell = strings.TrimSpace(cell)
+		if !delimiterCell.MatchString(cell) {
+			return nil, false
+		}
+		left, right := strings.HasPrefix(cell, ":"), strings.HasSuffix(cell, ":")
+		switch {
+		case left && right:
+			align[i] = AlignCenter
+		case right:
+			align[i] = AlignRight
+		case left:
+			align[i] = AlignLeft
+		}
+	}
+	return align, len(cells) > 0
+}
+
+// splitTableRow splits a row on unescaped pipes outside code spans, dropping
+// the optional leading and trailing pipe. Escaped pipes and backslashes
+// become plain ones, and <br> tags line breaks unless escaped as \<br>.
+func splitTableRow(line string) []string {
+	line = strings.TrimSpace(line)
+
+	var cells []string
+	var cell strings.Builder
+	trailingPipe := false
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		trailingPipe = false
+		switch {
+		case c == '\\' && i+1 < len(line):
+			if line[i+1] != '|' && line[i+1] != '\\' && lineBreakAt(line[i+1:]) == 0 {
+				cell.WriteByte(c)
+			}
+			cell.WriteByte(line[i+1])
+			i++
+		case c == '`':
+			ticks := i
+			for ticks < len(line) && line[ticks] == '`' {
+				ticks++
+			}
+			if end := codeSpanEnd(line, ticks, ticks-i); end > 0 {
+				cell.WriteString(lineBreak.ReplaceAllString(strings.ReplaceAll(line[i:end], `\|`, "|"), "\n"))
+				i = end - 1
+			} else {
+				cell.WriteString(line[i:ticks])
+				i = ticks - 1
+			}
+		case lineBreakAt(line[i:]) > 0:
+			cell.WriteByte('\n')
+			i += lineBreakAt(line[i:]) - 1
+		case c == '|':
+			cells = append(cells, cell.String())
+			cell.Reset()
+			trailingPipe = true
+		default:
+			cell.WriteByte(c)
+		}
+	}
+	if !trailingPipe {
+		cells = append(cells, cell.String())
+	}
+	if strings.HasPrefix(line, "|") && len(cells) > 0 {
+		cells = cells[1:]
+	}
+	return cells
+}
+
+// codeSpanEnd finds the end of the backtick run of length n closing a code
+// span opened before from, or -1 if the span is never closed
+func codeSpanEnd(line string, from, n int) int {
+	for i := from; i < len(line); {
+		if line[i] != '`' {
+			i++
+			continue
+		}
+		end := i
+		for end < len(line) && line[end] == '`' {
+			end++
+		}
+		if end-i == n {
+			return end
+		}
+		i = end
+	}
+	return -1
+}
+
+// markdownCell trims the spaces around a cell, keeping line breaks
+func markdownCell(cell string) string {
+	return strings.Trim(cell, " \t")
+}
+
+// lineBreakAt returns the length of the <br> tag at the start of text, or 0
+func lineBreakAt(text string) int {
+	return len(lineBreakTag.FindString(text))
+}
@@ -0,0 +1,155 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMarkdownParser_Parse(t *testing.T) {
+	tests := []struct {
+		name    string
+		parser  *MarkdownParser
+		input   string
+		want    *TableData
+		wantErr bool
+	}{
+		{
+			name:   "Simple Table",
+			parser: &MarkdownParser{},
+			input:  "| Name | Age |\n| --- | --- |\n| John | 30 |\n| Jane | 25 |\n",
+			want: &TableData{
+				Headers: []string{"Name", "Age"},
+				Rows: []map[string]string{
+					{"Name": "John", "Age": "30"},
+					{"Name": "Jane", "Age": "25"},
+				},
+			},
+		},
+		{
+			name:   "Alignment",
+			parser: &MarkdownParser{},
+			input:  "| Item | Qty | Note | Price |\n|:-----|:---:|------|------:|\n| Tea | 2 | hot | 3.50 |\n",
+			want: &TableData{
+				Headers: []string{"Item", "Qty", "Note", "Price"},
+				Rows: []map[string]string{
+					{"Item": "Tea", "Qty": "2", "Note": "hot", "Price": "3.50"},
+				},
+				Align: map[string]Alignment{"Item": AlignLeft, "Qty": AlignCenter, "Price": AlignRight},
+			},
+		},
+		{
+			name:   "No Outer Pipes",
+			parser: &MarkdownParser{},
+			input:  "a | b\n--|--\n1 | 2\n3 |\n",
+			want: &TableData{
+				Headers: []string{"a", "b"},
+				Rows: []map[string]string{
+					{"a": "1", "b": "2"},
+					{"a": "3", "b": ""},
+				},
+			},
+		},
+		{
+			name:   "Escaped Pipes And Code Spans",
+			parser: &MarkdownParser{},
+			input:  "| Expr | Meaning |\n| --- | --- |\n| `a || b` | either |\n| x \\| y | `p \\| q` |\n| ``a`|`b`` | nested |\n",
+			want: &TableData{
+				Headers: []string{"Expr", "Meaning"},
+				Rows: []map[string]string{
+					{"Expr": "`a || b`", "Meaning": "either"},
+					{"Expr": "x | y", "Meaning": "`p | q`"},
+					{"Expr": "``a`|`b``", "Meaning": "nested"},
+				},
+			},
+		},
+		{
+			name:   "Escaped Backslashes",
+			parser: &MarkdownParser{},
+			input:  "| Path | Expr |\n| --- | --- |\n| C:\\tmp\\\\ | x\\\\\\|y |\n",
+			want: &TableData{
+				Headers: []string{"Path", "Expr"},
+				Rows:    []map[string]string{{"Path": `C:\tmp\`, "Expr": `x\|y`}},
+			},
+		},
+		{
+			name:   "Line Breaks And Ragged Rows",
+			parser: &MarkdownParser{},
+			input:  "| a | b |\n|---|---|\n| one<br>two | x |\n| 1 | 2 | 3 |\n",
+			want: &TableData{
+				Headers: []string{"a", "b"},
+				Rows: []map[string]string{
+					{"a": "one\ntwo", "b": "x"},
+					{"a": "1", "b": "2"},
+				},
+				Warnings: []Warning{{Line: 4, Message: "3 cells, expected 2; surplus cells dropped"}},
+			},
+		},
+		{
+			name:   "Escaped Line Break Tag",
+			parser: &MarkdownParser{},
+			input:  "| a |\n|---|\n| \\<br> is a tag<br/>`x<br>y` |\n| <br>z |\n",
+			want: &TableData{
+				Headers: []string{"a"},
+				Rows: []map[string]string{
+					{"a": "<br> is a tag\n`x\ny`"},
+					{"a": "\nz"},
+				},
+			},
+		},
+		{
+			name:   "Second Table After Heading",
+			parser: &MarkdownParser{Index: 1},
+			input:  "# Docs\n\n| a |\n|---|\n| 1 |\n\n```\n| not | a |\n|-----|---|\n```\n\nPrices\n======\n\nSome text.\n| b |\n| - |\n| 2 |\n> quote\n",
+			want: &TableData{
+				Name:    "Prices",
+				Headers: []string{"b"},
+				Rows:    []map[string]string{{"b": "2"}},
+			},
+		},
+		{
+			name:    "Setext Heading Is Not A Table",
+			parser:  &MarkdownParser{},
+			input:   "Title\n---\n\ntext | more\n",
+			wantErr: true,
+		},
+		{
+			name:    "Index Out Of Range",
+			parser:  &MarkdownParser{Index: 1},
+			input:   "| a |\n|---|\n| 1 |\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parser.Parse([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarkdownParser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarkdownParser.Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkdownParser_ParseAll(t *testing.T) {
+	input := "## Users\n| id | name |\n|----|------|\n| 1 | Ann |\n\n## Groups\n| id | title |\n|----|-------|\n| 7 | admins |\n"
+
+	tables, err := (&MarkdownParser{}).ParseAll([]byte(input))
+	if err != nil {
+		t.Fatalf("MarkdownParser.ParseAll() error = %v", err)
+	}
+
+	var names []string
+	for _, table := range tables {
+		names = append(names, table.Name)
+	}
+	if want := []string{"Users", "Groups"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("MarkdownParser.ParseAll() names = %q, want %q", names, want)
+	}
+	if want := []map[string]string{{"id": "7", "title": "admins"}}; !reflect.DeepEqual(tables[1].Rows, want) {
+		t.Errorf("MarkdownParser.ParseAll() rows = %q, want %q", tables[1].Rows, want)
+	}
+}
@@ -0,0 +1,40 @@
+package parser
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+)
+
+// ParquetParser implements Parser for Apache Parquet files. Row groups are
+// read one record batch at a time and columns take their type from the
+// Parquet schema. Every standard compression codec is supported.
+type ParquetParser struct{}
+
+func (p *ParquetParser) Parse(input []byte) (*TableData, error) {
+	if len(input) == 0 {
+		return nil, fmt.Errorf("empty Parquet input")
+	}
+
+	pf, err := file.NewParquetReader(bytes.NewReader(input))
+	if err != nil {
+		return nil, fmt.Errorf("invalid Parquet file: %v", err)
+	}
+	defer pf.Close()
+
+	fr, err := pqarrow.NewFileReader(pf, pqarrow.ArrowReadProperties{BatchSize: 4096}, memory.DefaultAllocator)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := fr.GetRecordReader(context.Background(), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Release()
+
+	return arrowTable(reader.Schema(), reader)
+}
@@ -0,0 +1,73 @@
+package parser
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/compress"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+)
+
+func TestParquetParser_Parse(t *testing.T) {
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "id", Type: arrow.PrimitiveTypes.Int32},
+		{Name: "score", Type: arrow.PrimitiveTypes.Float32, Nullable: true},
+		{Name: "day", Type: arrow.FixedWidthTypes.Date32},
+		{Name: "at", Type: &arrow.TimestampType{Unit: arrow.Millisecond, TimeZone: "UTC"}},
+		{Name: "tags", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
+		{Name: "ok", Type: arrow.FixedWidthTypes.Boolean},
+	}, nil)
+
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer builder.Release()
+	builder.Field(0).(*array.Int32Builder).AppendValues([]int32{1, 2}, nil)
+	builder.Field(1).(*array.Float32Builder).AppendValues([]float32{1.5, 0}, []bool{true, false})
+	builder.Field(2).(*array.Date32Builder).AppendValues([]arrow.Date32{19000, 19001}, nil)
+	builder.Field(3).(*array.TimestampBuilder).AppendValues([]arrow.Timestamp{0, 1500}, nil)
+	tags := builder.Field(4).(*array.ListBuilder)
+	tags.Append(true)
+	tags.ValueBuilder().(*array.StringBuilder).AppendValues([]string{"a", "b"}, nil)
+	tags.AppendNull()
+	builder.Field(5).(*array.BooleanBuilder).AppendValues([]bool{true, false}, nil)
+	record := builder.NewRecord()
+	defer record.Release()
+
+	// Two row groups, compressed with zstd
+	var buf bytes.Buffer
+	props := parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Zstd), parquet.WithMaxRowGroupLength(1))
+	writer, err := pqarrow.NewFileWriter(schema, &buf, props, pqarrow.DefaultWriterProps())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Write(record); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := (&ParquetParser{}).Parse(buf.Bytes())
+	if err != nil {
+		t.Fatalf("ParquetParser.Parse() error = %v", err)
+	}
+	want := &TableData{
+		Headers: []string{"id", "score", "day", "at", "tags", "ok"},
+		Rows: []map[string]string{
+			{"id": "1", "score": "1.5", "day": "2022-01-08", "at": "1970-01-01T00:00:00Z", "tags": `["a","b"]`, "ok": "true"},
+			{"id": "2", "day": "2022-01-09", "at": "1970-01-01T00:00:01.5Z", "ok": "false"},
+		},
+		Types: map[string]ColumnType{"id": TypeInteger, "score": TypeFloat, "day": TypeDate, "at": TypeTimestamp, "ok": TypeBoolean},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParquetParser.Parse() = %+v, want %+v", got, want)
+	}
+
+	if _, err := (&ParquetParser{}).Parse([]byte("id,name\n1,John\n")); err == nil {
+		t.Errorf("ParquetParser.Parse() of CSV should fail")
+	}
+}
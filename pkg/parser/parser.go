@@ -1,18 +1,78 @@
 package parser
 
 import (
-	"encoding/csv"
-	"encoding/json"
-	"encoding/xml"
 	"fmt"
-	"io"
 	"strings"
 )
 
 // TableData represents the parsed data structure
 type TableData struct {
+	// Name identifies the table within its source, e.g. an HTML caption
+	Name    string
 	Headers []string
 	Rows    []map[string]string
+	// Footer holds summary rows, such as an HTML tfoot, kept apart from Rows
+	Footer []map[string]string
+	// Types records the kind of values in each column for sources that have
+	// one, such as JSON. Columns without an entry hold plain strings.
+	Types map[string]ColumnType
+	// Rich holds the formatted content of cells in Rows that carry links, line
+	// breaks or emphasis. It is indexed like Rows and nil for plain tables.
+	Rich []map[string]RichText
+	// Align holds the horizontal alignment of columns for sources that
+	// declare one, such as a Markdown delimiter row
+	Align map[string]Alignment
+	// Namespaces maps the namespace prefixes of XML column names, as in
+	// dc:title, to their URIs
+	Namespaces map[string]string
+	// Warnings lists problems in the input that the parser worked around
+	Warnings []Warning
+}
+
+// Alignment is the horizontal alignment of a column
+type Alignment string
+
+const (
+	AlignLeft   Alignment = "left"
+	AlignCenter Alignment = "center"
+	AlignRight  Alignment = "right"
+)
+
+// Warning reports a problem found at a line of the input
+type Warning struct {
+	Line    int
+	Message string
+}
+
+func (w Warning) String() string {
+	if w.Line == 0 {
+		return w.Message
+	}
+	return fmt.Sprintf("line %d: %s", w.Line, w.Message)
+}
+
+// ColumnType describes the kind of values a column holds
+type ColumnType string
+
+const (
+	TypeString  ColumnType = "string"
+	TypeInteger ColumnType = "integer"
+	TypeFloat   ColumnType = "float"
+	TypeBoolean ColumnType = "boolean"
+	// TypeDate, TypeTimestamp and TypeTime hold ISO 8601 text such as
+	// 2006-01-02, 2006-01-02T15:04:05Z07:00 and 15:04:05. Timestamps
+	// without an offset are wall clock times.
+	TypeDate      ColumnType = "date"
+	TypeTimestamp ColumnType = "timestamp"
+	TypeTime      ColumnType = "time"
+)
+
+// ColumnType returns the type of a column, defaulting to TypeString
+func (t *TableData) ColumnType(header string) ColumnType {
+	if kind, ok := t.Types[header]; ok {
+		return kind
+	}
+	return TypeString
 }
 
 // Parser interface for different input formats
@@ -20,120 +80,66 @@ type Parser interface {
 	Parse(input []byte) (*TableData, error)
 }
 
-// JSONParser implements Parser for JSON input
-type JSONParser struct{}
-
-// CSVParser implements Parser for CSV input
-type CSVParser struct{}
-
-// XMLParser implements Parser for XML input
-type XMLParser struct{}
+// MultiTableParser is implemented by parsers that can extract every table
+// from an input holding several of them
+type MultiTableParser interface {
+	ParseAll(input []byte) ([]*TableData, error)
+}
 
 func NewParser(fileType string) (Parser, error) {
 	switch strings.ToLower(fileType) {
 	case "json":
 		return &JSONParser{}, nil
+	case "jsonl", "ndjson":
+		return &JSONLParser{}, nil
 	case "csv":
 		return &CSVParser{}, nil
+	case "tsv":
+		return &CSVParser{Delimiter: '\t', LazyQuotes: true}, nil
 	case "xml":
 		return &XMLParser{}, nil
+	case "markdown", "md":
+		return &MarkdownParser{}, nil
+	case "ascii", "txt":
+		return &ASCIIParser{}, nil
+	case "fixed", "fwf":
+		return &FixedWidthParser{}, nil
+	case "yaml", "yml":
+		return &YAMLParser{}, nil
+	case "toml":
+		return &TOMLParser{}, nil
 	case "html":
 		return &HTMLParser{}, nil
 	case "xlsx":
 		return &ExcelParser{}, nil
+	case "sqlite", "sqlite3", "db":
+		return &SQLiteParser{}, nil
+	case "sql":
+		return &SQLParser{}, nil
+	case "parquet":
+		return &ParquetParser{}, nil
+	case "arrow", "arrows", "feather", "ipc":
+		return &ArrowParser{}, nil
 	default:
 		return nil, fmt.Errorf("unsupported input format: %s", fileType)
 	}
 }
 
-func (p *JSONParser) Parse(input []byte) (*TableData, error) {
-	var rawData []map[string]interface{}
-	if err := json.Unmarshal(input, &rawData); err != nil {
-		return nil, err
-	}
-
-	if len(rawData) == 0 {
-		return nil, fmt.Errorf("empty JSON array")
-	}
-
-	// Extract headers from first object
-	headers := make([]string, 0)
-	for key := range rawData[0] {
-		headers = append(headers, key)
-	}
-
-	// Convert data rows
-	rows := make([]map[string]string, len(rawData)-1)
-	for i := 1; i < len(rawData); i++ {
-		row := make(map[string]string)
-		for _, h := range headers {
-			row[h] = fmt.Sprintf("%v", rawData[i][h])
+// uniqueHeaders names empty headers after their position and suffixes
+// duplicates so every column can be used as a row key
+func uniqueHeaders(headers []string) []string {
+	seen := make(map[string]bool)
+	result := make([]string, len(headers))
+	for i, h := range headers {
+		if h == "" {
+			h = fmt.Sprintf("Column %d", i+1)
 		}
-		rows[i-1] = row
-	}
-
-	return &TableData{
-		Headers: headers,
-		Rows:    rows,
-	}, nil
-}
-
-func (p *CSVParser) Parse(input []byte) (*TableData, error) {
-	reader := csv.NewReader(strings.NewReader(string(input)))
-
-	// Read headers
-	headers, err := reader.Read()
-	if err != nil {
-		return nil, err
-	}
-
-	// Read rows
-	var rows []map[string]string
-	for {
-		record, err := reader.Read()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			return nil, err
+		name := h
+		for n := 2; seen[name]; n++ {
+			name = fmt.Sprintf("%s_%d", h, n)
 		}
-
-		row := make(map[string]string)
-		for i, value := range record {
-			row[headers[i]] = value
-		}
-		rows = append(rows, row)
+		seen[name] = true
+		result[i] = name
 	}
-
-	return &TableData{
-		Headers: headers,
-		Rows:    rows,
-	}, nil
-}
-
-func (p *XMLParser) Parse(input []byte) (*TableData, error) {
-	// Implementation depends on your XML structure
-	// This is a basic example
-	var data struct {
-		Rows []map[string]string `xml:"row"`
-	}
-
-	if err := xml.Unmarshal(input, &data); err != nil {
-		return nil, err
-	}
-
-	if len(data.Rows) == 0 {
-		return nil, fmt.Errorf("empty XML data")
-	}
-
-	// Extract headers from first row
-	headers := make([]string, 0)
-	for key := range data.Rows[0] {
-		headers = append(headers, key)
-	}
-
-	return &TableData{
-		Headers: headers,
-		Rows:    data.Rows[1:],
-	}, nil
+	return result
 }
@@ -13,8 +13,20 @@ func TestNewParser(t *testing.T) {
 		wantErr  bool
 	}{
 		{"JSON Parser", "json", "*parser.JSONParser", false},
+		{"JSON Lines Parser", "jsonl", "*parser.JSONLParser", false},
+		{"NDJSON Parser", "ndjson", "*parser.JSONLParser", false},
 		{"CSV Parser", "csv", "*parser.CSVParser", false},
+		{"TSV Parser", "tsv", "*parser.CSVParser", false},
+		{"Markdown Parser", "markdown", "*parser.MarkdownParser", false},
+		{"ASCII Parser", "ascii", "*parser.ASCIIParser", false},
+		{"Fixed-width Parser", "fixed", "*parser.FixedWidthParser", false},
 		{"XML Parser", "xml", "*parser.XMLParser", false},
+		{"YAML Parser", "yaml", "*parser.YAMLParser", false},
+		{"TOML Parser", "toml", "*parser.TOMLParser", false},
+		{"SQLite Parser", "sqlite", "*parser.SQLiteParser", false},
+		{"SQL Parser", "sql", "*parser.SQLParser", false},
+		{"Parquet Parser", "parquet", "*parser.ParquetParser", false},
+		{"Arrow Parser", "feather", "*parser.ArrowParser", false},
 		{"HTML Parser", "html", "*parser.HTMLParser", false},
 		{"Excel Parser", "xlsx", "*parser.ExcelParser", false},
 		{"Invalid Parser", "invalid", "", true},
@@ -0,0 +1,468 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ArrayMode selects how arrays inside records are turned into cells
+type ArrayMode string
+
+const (
+	// ArrayIndex spreads items over index-suffixed columns: tags.0, tags.1
+	ArrayIndex ArrayMode = "index"
+	// ArrayJoin joins scalar items into a single cell
+	ArrayJoin ArrayMode = "join"
+	// ArrayJSON keeps the array as JSON text in a single cell
+	ArrayJSON ArrayMode = "json"
+)
+
+// ParseArrayMode returns the array mode with the given name, ArrayIndex for
+// an empty one
+func ParseArrayMode(name string) (ArrayMode, error) {
+	switch mode := ArrayMode(strings.ToLower(strings.TrimSpace(name))); mode {
+	case "":
+		return ArrayIndex, nil
+	case ArrayIndex, ArrayJoin, ArrayJSON:
+		return mode, nil
+	}
+	return "", fmt.Errorf("unknown array mode %q: use index, join or json", name)
+}
+
+// FlattenOptions controls how nested records are spread over columns. Nested
+// objects always become dotted column names such as address.city.
+type FlattenOptions struct {
+	// Separator joins the keys of nested values, "." by default
+	Separator string
+	// Arrays selects the array handling, ArrayIndex by default
+	Arrays ArrayMode
+	// JoinSeparator separates items in ArrayJoin mode, ", " by default
+	JoinSeparator string
+	// Explode names an array field whose items each produce their own row,
+	// repeating the other fields of the record
+	Explode string
+}
+
+// object is a decoded record that keeps its keys in document order
+type object struct {
+	keys   []string
+	values map[string]any
+}
+
+func newObject() *object {
+	return &object{values: make(map[string]any)}
+}
+
+func (o *object) set(key string, value any) {
+	if _, ok := o.values[key]; !ok {
+		o.keys = append(o.keys, key)
+	}
+	o.values[key] = value
+}
+
+// decodeJSON reads the next JSON value from dec. Objects are returned as
+// *object to keep key order and numbers as json.Number to keep their literal.
+func decodeJSON(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t := tok.(type) {
+	case json.Delim:
+		switch t {
+		case '{':
+			obj := newObject()
+			for dec.More() {
+				keyTok, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				value, err := decodeJSON(dec)
+				if err != nil {
+					return nil, err
+				}
+				obj.set(keyTok.(string), value)
+			}
+			if _, err := dec.Token(); err != nil {
+				return nil, err
+			}
+			return obj, nil
+		case '[':
+			list := []any{}
+			for dec.More() {
+				value, err := decodeJSON(dec)
+				if err != nil {
+					return nil, err
+				}
+				list = append(list, value)
+			}
+			if _, err := dec.Token(); err != nil {
+				return nil, err
+			}
+			return list, nil
+		}
+		return nil, fmt.Errorf("unexpected %v", t)
+	default:
+		return t, nil
+	}
+}
+
+// parseJSONDocument decodes a complete JSON document
+func parseJSONDocument(input []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(input))
+	dec.UseNumber()
+	value, err := decodeJSON(dec)
+	if err == io.EOF {
+		return nil, fmt.Errorf("empty JSON input")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after JSON value")
+	}
+	return value, nil
+}
+
+// tableRecords finds the records in a decoded value. Arrays are used as they
+// are. An object whose values are all objects becomes one record per key,
+// stored in keyColumn, and an object whose values are all arrays of scalars
+// is read column by column.
+func tableRecords(value any, keyColumn string) ([]any, error) {
+	switch v := value.(type) {
+	case []any:
+		return v, nil
+	case *object:
+		if len(v.keys) == 0 {
+			return nil, fmt.Errorf("empty object")
+		}
+		if keyColumn == "" {
+			keyColumn = "key"
+		}
+
+		switch {
+		case allValues(v, isObject):
+			records := make([]any, len(v.keys))
+			for i, key := range v.keys {
+				record := newObject()
+				record.set(keyColumn, key)
+				inner := v.values[key].(*object)
+				for _, k := range inner.keys {
+					record.set(k, inner.values[k])
+				}
+				records[i] = record
+			}
+			return records, nil
+		case allValues(v, isScalarArray):
+			var records []any
+			for _, key := range v.keys {
+				for i, item := range v.values[key].([]any) {
+					if i == len(records) {
+						records = append(records, newObject())
+					}
+					records[i].(*object).set(key, item)
+				}
+			}
+			return records, nil
+		}
+		return nil, fmt.Errorf("object is not a table of records; select the records with a path")
+	default:
+		return nil, fmt.Errorf("value is not a table of records")
+	}
+}
+
+func allValues(obj *object, test func(any) bool) bool {
+	for _, key := range obj.keys {
+		if !test(obj.values[key]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isObject(value any) bool {
+	_, ok := value.(*object)
+	return ok
+}
+
+func isScalarArray(value any) bool {
+	list, ok := value.([]any)
+	if !ok {
+		return false
+	}
+	for _, item := range list {
+		if isObject(item) {
+			return false
+		}
+		if _, nested := item.([]any); nested {
+			return false
+		}
+	}
+	return true
+}
+
+// recordTable turns decoded records into a table. Headers are the union of
+// the flattened record keys in first-seen order; null values are left out of
+// the row. Arrays of scalars become a single "value" column.
+func recordTable(records []any, opts FlattenOptions) (*TableData, error) {
+	if opts.Explode != "" {
+		records = opts.explode(records)
+	}
+
+	var headers []string
+	seen := make(map[string]bool)
+	types := newTypeTracker()
+	rows := make([]map[string]string, 0, len(records))
+
+	for i, record := range records {
+		obj, ok := record.(*object)
+		if !ok {
+			if _, nested := record.([]any); nested {
+				return nil, fmt.Errorf("record %d is an array, not an object", i+1)
+			}
+			obj = newObject()
+			obj.set("value", record)
+		}
+
+		row := make(map[string]string)
+		for _, key := range obj.keys {
+			opts.flatten(key, obj.values[key], func(key string, value any) {
+				if !seen[key] {
+					seen[key] = true
+					headers = append(headers, key)
+				}
+				text, kind, ok := cellValue(value)
+				if !ok {
+					return
+				}
+				row[key] = text
+				types.add(key, kind)
+			})
+		}
+		rows = append(rows, row)
+	}
+
+	return &TableData{
+		Headers: headers,
+		Rows:    rows,
+		Types:   types.result(),
+	}, nil
+}
+
+func (o FlattenOptions) separator() string {
+	if o.Separator == "" {
+		return "."
+	}
+	return o.Separator
+}
+
+// flatten reports the leaf values of a nested value under their column names
+func (o FlattenOptions) flatten(prefix string, value any, add func(key string, value any)) {
+	sep := o.separator()
+	switch v := value.(type) {
+	case *object:
+		if len(v.keys) == 0 {
+			add(prefix, v)
+			return
+		}
+		for _, key := range v.keys {
+			o.flatten(prefix+sep+key, v.values[key], add)
+		}
+	case []any:
+		switch o.Arrays {
+		case ArrayJSON:
+			add(prefix, v)
+		case ArrayJoin:
+			joinSep := o.JoinSeparator
+			if joinSep == "" {
+				joinSep = ", "
+			}
+			items := make([]string, len(v))
+			for i, item := range v {
+				if _, nested := item.(*object); nested {
+					add(prefix, v)
+					return
+				}
+				if _, nested := item.([]any); nested {
+					add(prefix, v)
+					return
+				}
+				items[i], _, _ = cellValue(item)
+			}
+			add(prefix, strings.Join(items, joinSep))
+		default:
+			// An empty array has no items to give columns
+			for i, item := range v {
+				o.flatten(prefix+sep+strconv.Itoa(i), item, add)
+			}
+		}
+	default:
+		add(prefix, value)
+	}
+}
+
+// explode replaces every record whose Explode field holds an array with one
+// record per array item
+func (o FlattenOptions) explode(records []any) []any {
+	path := strings.Split(o.Explode, o.separator())
+	var result []any
+	for _, record := range records {
+		obj, ok := record.(*object)
+		if !ok {
+			result = append(result, record)
+			continue
+		}
+		items, ok := lookupPath(obj, path).([]any)
+		if !ok || len(items) == 0 {
+			result = append(result, record)
+			continue
+		}
+		for _, item := range items {
+			result = append(result, replacePath(obj, path, item))
+		}
+	}
+	return result
+}
+
+func lookupPath(obj *object, path []string) any {
+	value, ok := obj.values[path[0]]
+	if !ok || len(path) == 1 {
+		return value
+	}
+	child, ok := value.(*object)
+	if !ok {
+		return nil
+	}
+	return lookupPath(child, path[1:])
+}
+
+// replacePath returns a copy of obj with the value at path replaced
+func replacePath(obj *object, path []string, value any) *object {
+	clone := &object{keys: obj.keys, values: make(map[string]any, len(obj.values))}
+	for key, v := range obj.values {
+		clone.values[key] = v
+	}
+	if len(path) == 1 {
+		clone.values[path[0]] = value
+		return clone
+	}
+	if child, ok := obj.values[path[0]].(*object); ok {
+		clone.values[path[0]] = replacePath(child, path[1:], value)
+	}
+	return clone
+}
+
+// temporal is a date, time or timestamp from a source that has them, such
+// as TOML or YAML, kept as written
+type temporal struct {
+	text string
+	kind ColumnType
+}
+
+// cellValue formats a decoded value as cell text. It reports false for null.
+func cellValue(value any) (string, ColumnType, bool) {
+	switch v := value.(type) {
+	case nil:
+		return "", "", false
+	case string:
+		return v, TypeString, true
+	case temporal:
+		return v.text, v.kind, true
+	case bool:
+		if v {
+			return "true", TypeBoolean, true
+		}
+		return "false", TypeBoolean, true
+	case json.Number:
+		if strings.ContainsAny(string(v), ".eE") {
+			return string(v), TypeFloat, true
+		}
+		return string(v), TypeInteger, true
+	default:
+		// Nested objects and arrays are kept as compact JSON
+		var b strings.Builder
+		writeCompactJSON(&b, v)
+		return b.String(), TypeString, true
+	}
+}
+
+func writeCompactJSON(b *strings.Builder, value any) {
+	switch v := value.(type) {
+	case *object:
+		b.WriteString("{")
+		for i, key := range v.keys {
+			if i > 0 {
+				b.WriteString(",")
+			}
+			b.WriteString(quoteJSON(key) + ":")
+			writeCompactJSON(b, v.values[key])
+		}
+		b.WriteString("}")
+	case []any:
+		b.WriteString("[")
+		for i, item := range v {
+			if i > 0 {
+				b.WriteString(",")
+			}
+			writeCompactJSON(b, item)
+		}
+		b.WriteString("]")
+	case string:
+		b.WriteString(quoteJSON(v))
+	case temporal:
+		b.WriteString(quoteJSON(v.text))
+	case nil:
+		b.WriteString("null")
+	default:
+		fmt.Fprint(b, v)
+	}
+}
+
+func quoteJSON(s string) string {
+	var b strings.Builder
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	enc.Encode(s)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// typeTracker works out a single type per column from the values seen
+type typeTracker struct {
+	types map[string]ColumnType
+}
+
+func newTypeTracker() *typeTracker {
+	return &typeTracker{types: make(map[string]ColumnType)}
+}
+
+func (t *typeTracker) add(header string, kind ColumnType) {
+	current, ok := t.types[header]
+	switch {
+	case !ok || current == kind:
+		t.types[header] = kind
+	case (current == TypeInteger && kind == TypeFloat) || (current == TypeFloat && kind == TypeInteger):
+		t.types[header] = TypeFloat
+	default:
+		t.types[header] = TypeString
+	}
+}
+
+// result returns the non-string column types, or nil if every column is text
+func (t *typeTracker) result() map[string]ColumnType {
+	var types map[string]ColumnType
+	for header, kind := range t.types {
+		if kind == TypeString {
+			continue
+		}
+		if types == nil {
+			types = make(map[string]ColumnType)
+		}
+		types[header] = kind
+	}
+	return types
+}
@@ -0,0 +1,59 @@
+package parser
+
+import "strings"
+
+// Span is a run of cell text sharing the same formatting
+type Span struct {
+	Text   string
+	Link   string
+	Bold   bool
+	Italic bool
+	Code   bool
+}
+
+// RichText is the formatted content of a cell. Line breaks are kept as "\n"
+// inside the span text.
+type RichText []Span
+
+// String returns the plain text of the cell
+func (rt RichText) String() string {
+	var text strings.Builder
+	for _, span := range rt {
+		text.WriteString(span.Text)
+	}
+	return text.String()
+}
+
+// Link returns the first hyperlink in the cell, if any
+func (rt RichText) Link() string {
+	for _, span := range rt {
+		if span.Link != "" {
+			return span.Link
+		}
+	}
+	return ""
+}
+
+// Formatted reports whether the cell carries anything plain text would lose
+func (rt RichText) Formatted() bool {
+	for _, span := range rt {
+		if span.Link != "" || span.Bold || span.Italic || span.Code || strings.Contains(span.Text, "\n") {
+			return true
+		}
+	}
+	return false
+}
+
+// sameFormat reports whether two spans can be merged into one
+func (s Span) sameFormat(other Span) bool {
+	return s.Link == other.Link && s.Bold == other.Bold && s.Italic == other.Italic && s.Code == other.Code
+}
+
+// RichCell returns the formatted content of a data cell, if the parser kept any
+func (t *TableData) RichCell(row int, header string) (RichText, bool) {
+	if row >= len(t.Rich) || t.Rich[row] == nil {
+		return nil, false
+	}
+	rt, ok := t.Rich[row][header]
+	return rt, ok
+}
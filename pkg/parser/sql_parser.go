@@ -0,0 +1,527 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SQLParser implements Parser for SQL dumps made of INSERT statements, such
+// as those from pg_dump --inserts, mysqldump or sqlite3 .dump. Column names
+// come from the INSERT column list or an earlier CREATE TABLE statement.
+// Numbers and booleans keep their type, NULL becomes a missing cell and
+// other expressions, like NOW(), are kept as written. Backslashes escape
+// characters only in E'...' strings and in statements that quote names with
+// backticks, as MySQL does, and # starts a comment only in those and before
+// a statement. Other statements are skipped.
+type SQLParser struct {
+	// Table names the table to read, by default the first one with rows
+	Table string
+}
+
+type sqlTokenKind int
+
+const (
+	sqlWord sqlTokenKind = iota
+	sqlIdent
+	sqlString
+	sqlNumber
+	sqlPunct
+)
+
+// sqlToken is a lexical token of a SQL script. Text holds the decoded
+// value of strings and quoted identifiers.
+type sqlToken struct {
+	kind       sqlTokenKind
+	text       string
+	start, end int
+	line       int
+}
+
+// sqlDumpTable gathers the rows inserted into one table
+type sqlDumpTable struct {
+	data    *TableData
+	columns []string
+	types   *typeTracker
+}
+
+func (p *SQLParser) Parse(input []byte) (*TableData, error) {
+	tables, err := p.ParseAll(input)
+	if err != nil {
+		return nil, err
+	}
+	if p.Table == "" {
+		// Dumps often create every table before filling any
+		for _, data := range tables {
+			if len(data.Rows) > 0 {
+				return data, nil
+			}
+		}
+		return tables[0], nil
+	}
+	for _, data := range tables {
+		if strings.EqualFold(data.Name, p.Table) {
+			return data, nil
+		}
+	}
+	return nil, fmt.Errorf("table %q not found in SQL input", p.Table)
+}
+
+// ParseAll reads every table the script creates or inserts into
+func (p *SQLParser) ParseAll(input []byte) ([]*TableData, error) {
+	input, err := Decode(input, "")
+	if err != nil {
+		return nil, err
+	}
+	src := string(input)
+	tokens, err := lexSQL(src)
+	if err != nil {
+		return nil, err
+	}
+
+	var order []*sqlDumpTable
+	tables := make(map[string]*sqlDumpTable)
+	table := func(name string) *sqlDumpTable {
+		key := strings.ToLower(name)
+		if t, ok := tables[key]; ok {
+			return t
+		}
+		t := &sqlDumpTable{data: &TableData{Name: name}, types: newTypeTracker()}
+		tables[key] = t
+		order = append(order, t)
+		return t
+	}
+
+	for i := 0; i < len(tokens); {
+		start := i
+		// Find the end of the statement
+		end := i
+		for end < len(tokens) && !(tokens[end].kind == sqlPunct && tokens[end].text == ";") {
+			end++
+		}
+		statement := tokens[start:end]
+		i = end + 1
+
+		switch {
+		case isSQLWord(statement, 0, "INSERT") || isSQLWord(statement, 0, "REPLACE"):
+			if err := readInsert(src, statement, table); err != nil {
+				return nil, err
+			}
+		case isSQLWord(statement, 0, "CREATE"):
+			readCreateTable(statement, table)
+		}
+	}
+
+	if len(order) == 0 {
+		return nil, fmt.Errorf("no INSERT or CREATE TABLE statements found in SQL input")
+	}
+	result := make([]*TableData, len(order))
+	for i, t := range order {
+		t.data.Headers = uniqueHeaders(t.data.Headers)
+		t.data.Types = t.types.result()
+		result[i] = t.data
+	}
+	return result, nil
+}
+
+func isSQLWord(tokens []sqlToken, i int, word string) bool {
+	return i < len(tokens) && tokens[i].kind == sqlWord && strings.EqualFold(tokens[i].text, word)
+}
+
+func isSQLPunct(tokens []sqlToken, i int, punct string) bool {
+	return i < len(tokens) && tokens[i].kind == sqlPunct && tokens[i].text == punct
+}
+
+// sqlName reads a possibly qualified name such as public."users" and
+// returns its last part
+func sqlName(tokens []sqlToken, i int) (string, int) {
+	name := ""
+	for i < len(tokens) && (tokens[i].kind == sqlWord || tokens[i].kind == sqlIdent) {
+		name = tokens[i].text
+		i++
+		if !isSQLPunct(tokens, i, ".") {
+			break
+		}
+		i++
+	}
+	return name, i
+}
+
+// readCreateTable takes the column names of a table from its definition
+func readCreateTable(tokens []sqlToken, table func(string) *sqlDumpTable) {
+	i := 1
+	for i < len(tokens) && !isSQLWord(tokens, i, "TABLE") {
+		// CREATE TEMPORARY TABLE and the like; other objects are skipped
+		if isSQLWord(tokens, i, "VIEW") || isSQLWord(tokens, i, "INDEX") || tokens[i].kind != sqlWord {
+			return
+		}
+		i++
+	}
+	i++
+	if isSQLWord(tokens, i, "IF") {
+		i += 3 // IF NOT EXISTS
+	}
+	name, i := sqlName(tokens, i)
+	if name == "" || !isSQLPunct(tokens, i, "(") {
+		return
+	}
+
+	var columns []string
+	for _, item := range splitSQLList(tokens, i) {
+		if len(item) == 0 || (item[0].kind != sqlWord && item[0].kind != sqlIdent) {
+			continue
+		}
+		if item[0].kind == sqlWord {
+			switch strings.ToUpper(item[0].text) {
+			case "PRIMARY", "KEY", "UNIQUE", "CONSTRAINT", "INDEX", "FOREIGN", "CHECK", "FULLTEXT", "SPATIAL", "EXCLUDE":
+				continue
+			}
+		}
+		columns = append(columns, item[0].text)
+	}
+
+	t := table(name)
+	if len(t.data.Headers) == 0 {
+		t.columns = columns
+		t.data.Headers = append([]string(nil), columns...)
+	}
+}
+
+// insertModifiers are the words that may come between INSERT and the table
+var insertModifiers = map[string]bool{
+	"IGNORE": true, "LOW_PRIORITY": true, "DELAYED": true, "HIGH_PRIORITY": true,
+	"OR": true, "REPLACE": true, "ROLLBACK": true, "ABORT": true, "FAIL": true,
+}
+
+// readInsert adds the rows of an INSERT statement to its table
+func readInsert(src string, tokens []sqlToken, table func(string) *sqlDumpTable) error {
+	i := 1
+	// INSERT IGNORE INTO, INSERT OR REPLACE INTO and the like. MySQL lets
+	// INTO out.
+	for i < len(tokens) && tokens[i].kind == sqlWord && insertModifiers[strings.ToUpper(tokens[i].text)] {
+		i++
+	}
+	if isSQLWord(tokens, i, "INTO") {
+		i++
+	}
+	name, i := sqlName(tokens, i)
+	if name == "" {
+		return fmt.Errorf("line %d: INSERT without a table name", tokens[0].line)
+	}
+	t := table(name)
+
+	columns := t.columns
+	if isSQLPunct(tokens, i, "(") {
+		columns = nil
+		for _, item := range splitSQLList(tokens, i) {
+			if len(item) > 0 {
+				columns = append(columns, item[len(item)-1].text)
+			}
+		}
+		i = closingParen(tokens, i) + 1
+	}
+	if !isSQLWord(tokens, i, "VALUES") && !isSQLWord(tokens, i, "VALUE") {
+		// INSERT ... SELECT and other forms carry no literal rows
+		return nil
+	}
+	i++
+
+	for _, column := range columns {
+		if !containsHeader(t.data.Headers, column) {
+			t.data.Headers = append(t.data.Headers, column)
+		}
+	}
+
+	for isSQLPunct(tokens, i, "(") {
+		values := splitSQLList(tokens, i)
+		line := tokens[i].line
+		i = closingParen(tokens, i) + 1
+
+		if len(columns) == 0 {
+			// No column names anywhere: number the columns
+			for len(t.data.Headers) < len(values) {
+				t.data.Headers = append(t.data.Headers, "")
+			}
+			columns = t.data.Headers
+		}
+		if len(values) != len(columns) {
+			message := "missing values left null"
+			if len(values) > len(columns) {
+				message = "surplus values dropped"
+			}
+			t.data.Warnings = append(t.data.Warnings, Warning{
+				Line:    line,
+				Message: fmt.Sprintf("%d values, expected %d; %s", len(values), len(columns), message),
+			})
+		}
+
+		row := make(map[string]string, len(columns))
+		for n, value := range values {
+			if n >= len(columns) {
+				break
+			}
+			h := columns[n]
+			if h == "" {
+				h = fmt.Sprintf("Column %d", n+1)
+			}
+			text, kind, ok := sqlValue(src, value)
+			if !ok {
+				continue
+			}
+			row[h] = text
+			t.types.add(h, kind)
+		}
+		t.data.Rows = append(t.data.Rows, row)
+
+		if !isSQLPunct(tokens, i, ",") {
+			break
+		}
+		i++
+	}
+	return nil
+}
+
+func containsHeader(headers []string, header string) bool {
+	for _, h := range headers {
+		if h == header {
+			return true
+		}
+	}
+	return false
+}
+
+// closingParen returns the position of the parenthesis closing the one at i
+func closingParen(tokens []sqlToken, i int) int {
+	depth := 0
+	for ; i < len(tokens); i++ {
+		if tokens[i].kind != sqlPunct {
+			continue
+		}
+		switch tokens[i].text {
+		case "(":
+			depth++
+		case ")":
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return len(tokens) - 1
+}
+
+// splitSQLList splits the parenthesized list starting at i on its top-level commas
+func splitSQLList(tokens []sqlToken, i int) [][]sqlToken {
+	end := closingParen(tokens, i)
+	var items [][]sqlToken
+	var item []sqlToken
+	depth := 0
+	for _, token := range tokens[i+1 : end] {
+		if token.kind == sqlPunct {
+			switch token.text {
+			case "(":
+				depth++
+			case ")":
+				depth--
+			case ",":
+				if depth == 0 {
+					items = append(items, item)
+					item = nil
+					continue
+				}
+			}
+		}
+		item = append(item, token)
+	}
+	return append(items, item)
+}
+
+// sqlValue formats a value expression as cell text. It reports false for NULL.
+func sqlValue(src string, tokens []sqlToken) (string, ColumnType, bool) {
+	if len(tokens) == 0 {
+		return "", TypeString, true
+	}
+	if len(tokens) == 1 {
+		token := tokens[0]
+		switch token.kind {
+		case sqlString:
+			return token.text, TypeString, true
+		case sqlNumber:
+			return sqlNumberCell(token.text)
+		case sqlWord:
+			switch strings.ToUpper(token.text) {
+			case "NULL":
+				return "", "", false
+			case "TRUE":
+				return "true", TypeBoolean, true
+			case "FALSE":
+				return "false", TypeBoolean, true
+			}
+		}
+	}
+	if len(tokens) == 2 && tokens[0].kind == sqlPunct && tokens[1].kind == sqlNumber && (tokens[0].text == "-" || tokens[0].text == "+") {
+		text, kind, _ := sqlNumberCell(tokens[1].text)
+		if tokens[0].text == "-" {
+			text = "-" + text
+		}
+		return text, kind, true
+	}
+	// Any other expression is kept as written
+	return src[tokens[0].start:tokens[len(tokens)-1].end], TypeString, true
+}
+
+func sqlNumberCell(text string) (string, ColumnType, bool) {
+	if strings.ContainsAny(text, ".eE") {
+		if strings.HasPrefix(text, ".") {
+			text = "0" + text
+		}
+		return text, TypeFloat, true
+	}
+	return text, TypeInteger, true
+}
+
+// lexSQL splits a script into tokens, dropping whitespace and comments.
+// Backslash escapes are read in E'...' strings, and in all strings of
+// statements that quote identifiers with backticks, as MySQL dumps do.
+func lexSQL(src string) ([]sqlToken, error) {
+	// Statements that quote names with backticks are MySQL's: their strings
+	// take backslash escapes and # starts a comment. Other databases read a
+	// backslash as is and # as an operator, so # only starts a comment
+	// there before a statement begins.
+	mysql := false
+	var tokens []sqlToken
+	line := 1
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f':
+			i++
+		case strings.HasPrefix(src[i:], "--") || c == '#' && (mysql || len(tokens) == 0 || isSQLPunct(tokens, len(tokens)-1, ";")):
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated comment", line)
+			}
+			line += strings.Count(src[i:i+2+end], "\n")
+			i += end + 4
+		case c == '\'':
+			text, end, err := lexSQLQuoted(src, i, '\'', mysql)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", line, err)
+			}
+			tokens = append(tokens, sqlToken{kind: sqlString, text: text, start: i, end: end, line: line})
+			line += strings.Count(src[i:end], "\n")
+			i = end
+		case c == '"' || c == '`' || c == '[':
+			closing := c
+			if c == '[' {
+				closing = ']'
+			}
+			text, end, err := lexSQLQuoted(src, i, closing, false)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", line, err)
+			}
+			tokens = append(tokens, sqlToken{kind: sqlIdent, text: text, start: i, end: end, line: line})
+			mysql = mysql || c == '`'
+			i = end
+		case c >= '0' && c <= '9' || (c == '.' && i+1 < len(src) && src[i+1] >= '0' && src[i+1] <= '9'):
+			end := i
+			for end < len(src) {
+				d := src[end]
+				if d >= '0' && d <= '9' || d == '.' || d == 'e' || d == 'E' {
+					end++
+				} else if (d == '+' || d == '-') && (src[end-1] == 'e' || src[end-1] == 'E') {
+					end++
+				} else {
+					break
+				}
+			}
+			tokens = append(tokens, sqlToken{kind: sqlNumber, text: src[i:end], start: i, end: end, line: line})
+			i = end
+		case isSQLWordRune(src, i):
+			end := i
+			for end < len(src) && (isSQLWordRune(src, end) || src[end] >= '0' && src[end] <= '9' || src[end] == '$') {
+				_, size := utf8.DecodeRuneInString(src[end:])
+				end += size
+			}
+			word := src[i:end]
+			// String prefixes: N'...' (national), E'...' (escapes), X'...' and B'...'
+			if end < len(src) && src[end] == '\'' && len(word) == 1 && strings.ContainsAny(word, "NnEeXxBb") {
+				escapes := mysql || word == "E" || word == "e"
+				text, stop, err := lexSQLQuoted(src, end, '\'', escapes)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %v", line, err)
+				}
+				if strings.ContainsAny(word, "XxBb") {
+					text = src[i:stop]
+				}
+				tokens = append(tokens, sqlToken{kind: sqlString, text: text, start: i, end: stop, line: line})
+				line += strings.Count(src[i:stop], "\n")
+				i = stop
+				continue
+			}
+			tokens = append(tokens, sqlToken{kind: sqlWord, text: word, start: i, end: end, line: line})
+			i = end
+		default:
+			_, size := utf8.DecodeRuneInString(src[i:])
+			tokens = append(tokens, sqlToken{kind: sqlPunct, text: src[i : i+size], start: i, end: i + size, line: line})
+			if c == ';' {
+				mysql = false
+			}
+			i += size
+		}
+	}
+	return tokens, nil
+}
+
+func isSQLWordRune(src string, i int) bool {
+	r, _ := utf8.DecodeRuneInString(src[i:])
+	return r == '_' || unicode.IsLetter(r)
+}
+
+// lexSQLQuoted reads a quoted string or identifier starting at i. A doubled
+// closing quote stands for itself. It returns the decoded text and the
+// position after the closing quote.
+func lexSQLQuoted(src string, i int, closing byte, backslashes bool) (string, int, error) {
+	var text strings.Builder
+	for j := i + 1; j < len(src); j++ {
+		c := src[j]
+		switch {
+		case c == '\\' && backslashes && j+1 < len(src):
+			j++
+			switch src[j] {
+			case 'n':
+				text.WriteByte('\n')
+			case 't':
+				text.WriteByte('\t')
+			case 'r':
+				text.WriteByte('\r')
+			case '0':
+				text.WriteByte(0)
+			case 'b':
+				text.WriteByte('\b')
+			case 'Z':
+				text.WriteByte(0x1a)
+			default:
+				text.WriteByte(src[j])
+			}
+		case c == closing:
+			if j+1 < len(src) && src[j+1] == closing {
+				text.WriteByte(closing)
+				j++
+				continue
+			}
+			return text.String(), j + 1, nil
+		default:
+			text.WriteByte(c)
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated quoted text")
+}
@@ -0,0 +1,146 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSQLParser_Parse(t *testing.T) {
+	mysqlDump := "/*!40101 SET NAMES utf8mb4 */;\n" +
+		"CREATE TABLE `users` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  `name` varchar(50) DEFAULT NULL,\n" +
+		"  `score` decimal(5,2),\n" +
+		"  PRIMARY KEY (`id`)\n" +
+		");\n" +
+		"-- Dumping data\n" +
+		"INSERT INTO `users` VALUES (1,'O\\'Brien; \\\\ok',9.50),(2,NULL,-.5);\n"
+
+	tests := []struct {
+		name    string
+		parser  *SQLParser
+		input   string
+		want    *TableData
+		wantErr bool
+	}{
+		{
+			name:   "Column List",
+			parser: &SQLParser{},
+			input: `INSERT INTO public."people" ("id", "name", "active") VALUES
+  (1, 'O''Brien', TRUE),
+  (2, E'a\nb', NULL);
+INSERT INTO people (name, joined) VALUES ('Ann', NOW());`,
+			want: &TableData{
+				Name:    "people",
+				Headers: []string{"id", "name", "active", "joined"},
+				Rows: []map[string]string{
+					{"id": "1", "name": "O'Brien", "active": "true"},
+					{"id": "2", "name": "a\nb"},
+					{"name": "Ann", "joined": "NOW()"},
+				},
+				Types: map[string]ColumnType{"id": TypeInteger, "active": TypeBoolean},
+			},
+		},
+		{
+			name:   "MySQL Dump",
+			parser: &SQLParser{},
+			input:  mysqlDump,
+			want: &TableData{
+				Name:    "users",
+				Headers: []string{"id", "name", "score"},
+				Rows: []map[string]string{
+					{"id": "1", "name": `O'Brien; \ok`, "score": "9.50"},
+					{"id": "2", "score": "-0.5"},
+				},
+				Types: map[string]ColumnType{"id": TypeInteger, "score": TypeFloat},
+			},
+		},
+		{
+			name:   "Backslashes In Standard Strings",
+			parser: &SQLParser{},
+			input:  "CREATE TABLE `other` (x int);\nINSERT INTO paths (path, note) VALUES ('C:\\dir\\', E'tab\\there');",
+			want: &TableData{
+				Name:    "paths",
+				Headers: []string{"path", "note"},
+				Rows: []map[string]string{
+					{"path": `C:\dir\`, "note": "tab\there"},
+				},
+			},
+		},
+		{
+			name:   "Insert Without Into",
+			parser: &SQLParser{},
+			input:  "INSERT `t` (id, name) VALUES (1,'a\\'b');\nINSERT IGNORE t (id) VALUES (2);\nINSERT OR REPLACE INTO t (id, name) VALUES (3, 'c');",
+			want: &TableData{
+				Name:    "t",
+				Headers: []string{"id", "name"},
+				Rows: []map[string]string{
+					{"id": "1", "name": "a'b"},
+					{"id": "2"},
+					{"id": "3", "name": "c"},
+				},
+				Types: map[string]ColumnType{"id": TypeInteger},
+			},
+		},
+		{
+			name:   "Hash Comments And Operators",
+			parser: &SQLParser{},
+			input: "# MySQL dump\nINSERT INTO `t` (a, b) VALUES (1, 'x') # rest\n, (2, 'y');\n" +
+				"INSERT INTO t (a, b) VALUES (5 # 3, '#');",
+			want: &TableData{
+				Name:    "t",
+				Headers: []string{"a", "b"},
+				Rows: []map[string]string{
+					{"a": "1", "b": "x"},
+					{"a": "2", "b": "y"},
+					{"a": "5 # 3", "b": "#"},
+				},
+			},
+		},
+		{
+			name:   "Named Table And Numbered Columns",
+			parser: &SQLParser{Table: "B"},
+			input:  `CREATE TABLE a (x int); INSERT INTO b VALUES ('p', 'q'), ('r');`,
+			want: &TableData{
+				Name:    "b",
+				Headers: []string{"Column 1", "Column 2"},
+				Rows: []map[string]string{
+					{"Column 1": "p", "Column 2": "q"},
+					{"Column 1": "r"},
+				},
+				Warnings: []Warning{{Line: 1, Message: "1 values, expected 2; missing values left null"}},
+			},
+		},
+		{
+			name:    "Missing Table",
+			parser:  &SQLParser{Table: "c"},
+			input:   `INSERT INTO b VALUES (1);`,
+			wantErr: true,
+		},
+		{
+			name:    "Unterminated String",
+			parser:  &SQLParser{},
+			input:   `INSERT INTO b VALUES ('oops);`,
+			wantErr: true,
+		},
+		{
+			name:    "No Inserts",
+			parser:  &SQLParser{},
+			input:   `SELECT 1;`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parser.Parse([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SQLParser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SQLParser.Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
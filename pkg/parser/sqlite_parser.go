@@ -0,0 +1,217 @@
+package parser
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+// SQLiteParser implements Parser for SQLite database files. It reads a
+// table, or the result of a query, keeping integer, real and boolean
+// columns typed. NULLs become missing cells.
+type SQLiteParser struct {
+	// Table names the table to read, the first one in the database by default
+	Table string
+	// Query is a SELECT statement whose result is read instead of a table
+	Query string
+}
+
+var sqliteMagic = []byte("SQLite format 3\x00")
+
+func (p *SQLiteParser) Parse(input []byte) (*TableData, error) {
+	db, cleanup, err := openSQLite(input)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	if p.Query != "" {
+		return sqliteQuery(db, p.Query)
+	}
+
+	table := p.Table
+	if table == "" {
+		tables, err := sqliteTables(db)
+		if err != nil {
+			return nil, err
+		}
+		if len(tables) == 0 {
+			return nil, fmt.Errorf("no tables found in SQLite database")
+		}
+		table = tables[0]
+	}
+	data, err := sqliteQuery(db, "SELECT * FROM "+quoteIdent(table))
+	if err != nil {
+		return nil, err
+	}
+	data.Name = table
+	return data, nil
+}
+
+// ParseAll reads every table in the database
+func (p *SQLiteParser) ParseAll(input []byte) ([]*TableData, error) {
+	db, cleanup, err := openSQLite(input)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	tables, err := sqliteTables(db)
+	if err != nil {
+		return nil, err
+	}
+	if len(tables) == 0 {
+		return nil, fmt.Errorf("no tables found in SQLite database")
+	}
+
+	var result []*TableData
+	for _, table := range tables {
+		data, err := sqliteQuery(db, "SELECT * FROM "+quoteIdent(table))
+		if err != nil {
+			return nil, err
+		}
+		data.Name = table
+		result = append(result, data)
+	}
+	return result, nil
+}
+
+// openSQLite opens a read-only copy of the database. SQLite can only open
+// files, so the input is written to a temporary one first.
+func openSQLite(input []byte) (*sql.DB, func(), error) {
+	if len(input) == 0 {
+		return nil, nil, fmt.Errorf("empty SQLite input")
+	}
+	if !bytes.HasPrefix(input, sqliteMagic) {
+		return nil, nil, fmt.Errorf("input is not a SQLite database")
+	}
+
+	f, err := os.CreateTemp("", "gotable-*.db")
+	if err != nil {
+		return nil, nil, err
+	}
+	path := f.Name()
+	_, err = f.Write(input)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path)
+		return nil, nil, err
+	}
+
+	db, err := sql.Open("sqlite", "file:"+path+"?mode=ro")
+	if err != nil {
+		os.Remove(path)
+		return nil, nil, err
+	}
+	return db, func() {
+		db.Close()
+		os.Remove(path)
+	}, nil
+}
+
+// sqliteTables lists the user tables in the order they were created
+func sqliteTables(db *sql.DB) ([]string, error) {
+	rows, err := db.Query(`SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%' ORDER BY rowid`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tables []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		tables = append(tables, name)
+	}
+	return tables, rows.Err()
+}
+
+func sqliteQuery(db *sql.DB, query string) (*TableData, error) {
+	rows, err := db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+	headers := uniqueHeaders(columns)
+
+	types := newTypeTracker()
+	var tableRows []map[string]string
+	values := make([]any, len(columns))
+	pointers := make([]any, len(columns))
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, err
+		}
+		row := make(map[string]string, len(headers))
+		for i, h := range headers {
+			value, kind, ok := sqliteCell(values[i], columnTypes[i].DatabaseTypeName())
+			if !ok {
+				continue
+			}
+			row[h] = value
+			types.add(h, kind)
+		}
+		tableRows = append(tableRows, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &TableData{
+		Headers: headers,
+		Rows:    tableRows,
+		Types:   types.result(),
+	}, nil
+}
+
+// sqliteCell formats a stored value as cell text. Integers in columns
+// declared BOOLEAN read as true and false. It reports false for NULL.
+func sqliteCell(value any, declared string) (string, ColumnType, bool) {
+	switch v := value.(type) {
+	case nil:
+		return "", "", false
+	case int64:
+		if strings.EqualFold(declared, "BOOLEAN") && (v == 0 || v == 1) {
+			return strconv.FormatBool(v == 1), TypeBoolean, true
+		}
+		return strconv.FormatInt(v, 10), TypeInteger, true
+	case float64:
+		return cellValue(floatNumber(v))
+	case bool:
+		return cellValue(v)
+	case time.Time:
+		return v.Format(time.RFC3339Nano), TypeString, true
+	case []byte:
+		return bytesCell(v), TypeString, true
+	case string:
+		return v, TypeString, true
+	}
+	return fmt.Sprint(value), TypeString, true
+}
+
+// quoteIdent quotes a table or column name for SQL
+func quoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
@@ -0,0 +1,136 @@
+package parser
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// sqliteFixture builds a database with the given statements and returns its content
+func sqliteFixture(t *testing.T, statements ...string) []byte {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "test.db")
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, statement := range statements {
+		if _, err := db.Exec(statement); err != nil {
+			t.Fatal(err)
+		}
+	}
+	db.Close()
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return content
+}
+
+func TestSQLiteParser_Parse(t *testing.T) {
+	input := sqliteFixture(t,
+		`CREATE TABLE people (id INTEGER, name TEXT, score REAL, active BOOLEAN)`,
+		`INSERT INTO people VALUES (1, 'John', 9.5, 1), (2, 'Alice', NULL, 0)`,
+		`CREATE TABLE "order items" (sku TEXT, qty INTEGER)`,
+		`INSERT INTO "order items" VALUES ('A-1', 3)`,
+	)
+
+	tests := []struct {
+		name    string
+		parser  *SQLiteParser
+		input   []byte
+		want    *TableData
+		wantErr bool
+	}{
+		{
+			name:   "First Table",
+			parser: &SQLiteParser{},
+			input:  input,
+			want: &TableData{
+				Name:    "people",
+				Headers: []string{"id", "name", "score", "active"},
+				Rows: []map[string]string{
+					{"id": "1", "name": "John", "score": "9.5", "active": "true"},
+					{"id": "2", "name": "Alice", "active": "false"},
+				},
+				Types: map[string]ColumnType{
+					"id":     TypeInteger,
+					"score":  TypeFloat,
+					"active": TypeBoolean,
+				},
+			},
+		},
+		{
+			name:   "Named Table",
+			parser: &SQLiteParser{Table: "order items"},
+			input:  input,
+			want: &TableData{
+				Name:    "order items",
+				Headers: []string{"sku", "qty"},
+				Rows:    []map[string]string{{"sku": "A-1", "qty": "3"}},
+				Types:   map[string]ColumnType{"qty": TypeInteger},
+			},
+		},
+		{
+			name:   "Query",
+			parser: &SQLiteParser{Query: `SELECT name, id * 10 AS tens FROM people ORDER BY id DESC`},
+			input:  input,
+			want: &TableData{
+				Headers: []string{"name", "tens"},
+				Rows: []map[string]string{
+					{"name": "Alice", "tens": "20"},
+					{"name": "John", "tens": "10"},
+				},
+				Types: map[string]ColumnType{"tens": TypeInteger},
+			},
+		},
+		{
+			name:    "Missing Table",
+			parser:  &SQLiteParser{Table: "nope"},
+			input:   input,
+			wantErr: true,
+		},
+		{
+			name:    "Not A Database",
+			parser:  &SQLiteParser{},
+			input:   []byte("id,name\n1,John\n"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parser.Parse(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SQLiteParser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SQLiteParser.Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSQLiteParser_ParseAll(t *testing.T) {
+	input := sqliteFixture(t,
+		`CREATE TABLE a (x INTEGER)`,
+		`CREATE TABLE b (y TEXT)`,
+		`INSERT INTO b VALUES ('z')`,
+	)
+
+	tables, err := (&SQLiteParser{}).ParseAll(input)
+	if err != nil {
+		t.Fatalf("SQLiteParser.ParseAll() error = %v", err)
+	}
+	var names []string
+	for _, data := range tables {
+		names = append(names, data.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"a", "b"}) {
+		t.Errorf("SQLiteParser.ParseAll() tables = %v, want [a b]", names)
+	}
+}
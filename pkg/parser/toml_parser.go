@@ -0,0 +1,140 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+// TOMLParser implements Parser for TOML input, mapping it onto records the
+// same way JSONParser does. A document holding a single array of tables,
+// such as [[servers]], yields one record per table.
+type TOMLParser struct {
+	// Path and KeyColumn work as they do for JSONParser
+	Path      string
+	KeyColumn string
+	FlattenOptions
+}
+
+func (p *TOMLParser) Parse(input []byte) (*TableData, error) {
+	input, err := Decode(input, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var document map[string]any
+	meta, err := toml.Decode(string(input), &document)
+	if err != nil {
+		return nil, err
+	}
+	if len(document) == 0 {
+		return nil, fmt.Errorf("empty TOML input")
+	}
+
+	// The decoder returns maps, so rebuild the key order from the metadata
+	order := make(map[string][]string)
+	seen := make(map[string]bool)
+	for _, key := range meta.Keys() {
+		for i := range key {
+			path := strings.Join(key[:i+1], "\x00")
+			if !seen[path] {
+				seen[path] = true
+				parent := strings.Join(key[:i], "\x00")
+				order[parent] = append(order[parent], key[i])
+			}
+		}
+	}
+
+	var value any = tomlValue(document, "", order)
+	if p.Path != "" {
+		if value, err = evalPath(value, p.Path); err != nil {
+			return nil, err
+		}
+	} else {
+		value = unwrapRecords(value)
+	}
+
+	records, err := tableRecords(value, p.KeyColumn)
+	if err != nil {
+		return nil, fmt.Errorf("TOML input: %v", err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("no TOML records found")
+	}
+	return recordTable(records, p.FlattenOptions)
+}
+
+// tomlValue converts a decoded TOML value to the record model. Tables get
+// their keys in document order; path identifies the table in order.
+func tomlValue(value any, path string, order map[string][]string) any {
+	child := func(key string) string {
+		if path == "" {
+			return key
+		}
+		return path + "\x00" + key
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		keys := order[path]
+		// Keys the metadata does not list, if any, follow in sorted order
+		var rest []string
+		listed := make(map[string]bool, len(keys))
+		for _, key := range keys {
+			listed[key] = true
+		}
+		for key := range v {
+			if !listed[key] {
+				rest = append(rest, key)
+			}
+		}
+		sort.Strings(rest)
+
+		obj := newObject()
+		for _, key := range append(keys, rest...) {
+			if item, ok := v[key]; ok {
+				obj.set(key, tomlValue(item, child(key), order))
+			}
+		}
+		return obj
+	case []map[string]any:
+		items := make([]any, len(v))
+		for i, item := range v {
+			items[i] = tomlValue(item, path, order)
+		}
+		return items
+	case []any:
+		items := make([]any, len(v))
+		for i, item := range v {
+			items[i] = tomlValue(item, path, order)
+		}
+		return items
+	case int64:
+		return json.Number(strconv.FormatInt(v, 10))
+	case float64:
+		return floatNumber(v)
+	case time.Time:
+		return tomlTime(v)
+	}
+	return value
+}
+
+// tomlTime formats a date or time as it was written, along with its kind.
+// The decoder marks local dates and times, which have no offset, with zones
+// of their own.
+func tomlTime(t time.Time) temporal {
+	switch t.Location().String() {
+	case "datetime-local":
+		return temporal{t.Format("2006-01-02T15:04:05.999999999"), TypeTimestamp}
+	case "date-local":
+		return temporal{t.Format("2006-01-02"), TypeDate}
+	case "time-local":
+		return temporal{t.Format("15:04:05.999999999"), TypeTime}
+	}
+	return temporal{t.Format(time.RFC3339Nano), TypeTimestamp}
+}
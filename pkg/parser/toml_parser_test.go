@@ -0,0 +1,115 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTOMLParser_Parse(t *testing.T) {
+	tests := []struct {
+		name    string
+		parser  *TOMLParser
+		input   string
+		want    *TableData
+		wantErr bool
+	}{
+		{
+			name:   "Array Of Tables",
+			parser: &TOMLParser{},
+			input: `title = "servers"
+
+[[servers]]
+name = "alpha"
+port = 8080
+ratio = 1.0
+enabled = true
+born = 1979-05-27T07:32:00Z
+
+[servers.tags]
+role = "db"
+
+[[servers]]
+name = "beta"
+`,
+			want: &TableData{
+				Headers: []string{"name", "port", "ratio", "enabled", "born", "tags.role"},
+				Rows: []map[string]string{
+					{"name": "alpha", "port": "8080", "ratio": "1.0", "enabled": "true", "born": "1979-05-27T07:32:00Z", "tags.role": "db"},
+					{"name": "beta"},
+				},
+				Types: map[string]ColumnType{
+					"port":    TypeInteger,
+					"ratio":   TypeFloat,
+					"enabled": TypeBoolean,
+				},
+			},
+		},
+		{
+			name:   "Tables Keyed By Name",
+			parser: &TOMLParser{Path: "$.hosts", KeyColumn: "host"},
+			input: `[hosts.web]
+ip = "10.0.0.1"
+
+[hosts.db]
+ip = "10.0.0.2"
+`,
+			want: &TableData{
+				Headers: []string{"host", "ip"},
+				Rows: []map[string]string{
+					{"host": "web", "ip": "10.0.0.1"},
+					{"host": "db", "ip": "10.0.0.2"},
+				},
+			},
+		},
+		{
+			name:   "Dates And Times",
+			parser: &TOMLParser{},
+			input: `[[events]]
+offset = 1979-05-27T00:32:00.5-07:00
+local = 1979-05-27T07:32:00
+born = 1979-05-27
+at = 07:32:00.25
+`,
+			want: &TableData{
+				Headers: []string{"offset", "local", "born", "at"},
+				Rows: []map[string]string{
+					{"offset": "1979-05-27T00:32:00.5-07:00", "local": "1979-05-27T07:32:00", "born": "1979-05-27", "at": "07:32:00.25"},
+				},
+				Types: map[string]ColumnType{"offset": TypeTimestamp, "local": TypeTimestamp, "born": TypeDate, "at": TypeTime},
+			},
+		},
+		{
+			name:    "Invalid TOML",
+			parser:  &TOMLParser{},
+			input:   "name = ",
+			wantErr: true,
+		},
+		{
+			name:    "No Records",
+			parser:  &TOMLParser{},
+			input:   `title = "x"`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parser.Parse([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TOMLParser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got.Headers, tt.want.Headers) || !reflect.DeepEqual(got.Rows, tt.want.Rows) {
+				t.Errorf("TOMLParser.Parse() = %v %v, want %v %v", got.Headers, got.Rows, tt.want.Headers, tt.want.Rows)
+			}
+			for h, kind := range tt.want.Types {
+				if got.ColumnType(h) != kind {
+					t.Errorf("TOMLParser.Parse() type of %s = %v, want %v", h, got.ColumnType(h), kind)
+				}
+			}
+		})
+	}
+}
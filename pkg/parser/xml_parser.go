@@ -0,0 +1,334 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html/charset"
+	"golang.org/x/text/encoding/charmap"
+)
+
+// XMLParser implements Parser for XML input. Each record element becomes a
+// row; its attributes become @name columns and nested elements are flattened
+// into dotted column names.
+type XMLParser struct {
+	// RecordPath is the slash separated path of the repeating record element,
+	// e.g. /catalog/book, or //book to match at any depth. When empty the
+	// shallowest repeating element is used.
+	RecordPath string
+}
+
+// xmlElement is a record element buffered while it is being read
+type xmlElement struct {
+	name     string
+	attrs    [][2]string
+	children []*xmlElement
+	text     strings.Builder
+}
+
+// xmlNames resolves namespaced names, writing them with the prefix declared
+// in the document so dc:title and title stay distinct columns
+type xmlNames struct {
+	prefixes map[string]string
+}
+
+func (n *xmlNames) declare(attrs []xml.Attr) {
+	for _, attr := range attrs {
+		if attr.Name.Space == "xmlns" {
+			if _, ok := n.prefixes[attr.Value]; !ok {
+				n.prefixes[attr.Value] = attr.Name.Local
+			}
+		}
+	}
+}
+
+func (n *xmlNames) name(name xml.Name) string {
+	if prefix, ok := n.prefixes[name.Space]; ok && name.Space != "" {
+		return prefix + ":" + name.Local
+	}
+	return name.Local
+}
+
+// namespaces returns the URIs of the declared prefixes, or nil if there are
+// none
+func (n *xmlNames) namespaces() map[string]string {
+	if len(n.prefixes) == 0 {
+		return nil
+	}
+	namespaces := make(map[string]string)
+	for uri, prefix := range n.prefixes {
+		namespaces[prefix] = uri
+	}
+	return namespaces
+}
+
+func newXMLDecoder(input []byte) *xml.Decoder {
+	dec := xml.NewDecoder(bytes.NewReader(input))
+	utf8Input := utf8.Valid(input)
+	dec.CharsetReader = func(label string, r io.Reader) (io.Reader, error) {
+		// Input already converted to UTF-8 still carries its old declaration
+		if utf8Input {
+			return r, nil
+		}
+		return charset.NewReaderLabel(label, r)
+	}
+	return dec
+}
+
+func (p *XMLParser) Parse(input []byte) (*TableData, error) {
+	// Legacy encodings are left to the XML declaration
+	if enc, bom := detectEncoding(input); enc != charmap.Windows1252 {
+		decoded, err := decodeWith(input[len(bom):], enc)
+		if err != nil {
+			return nil, err
+		}
+		input = decoded
+	}
+
+	path := p.RecordPath
+	if path == "" {
+		detected, err := detectRecordPath(input)
+		if err != nil {
+			return nil, err
+		}
+		path = detected
+	}
+	segments, anyDepth := splitRecordPath(path)
+
+	dec := newXMLDecoder(input)
+	names := &xmlNames{prefixes: make(map[string]string)}
+
+	var headers []string
+	var rows []map[string]string
+	seen := make(map[string]bool)
+
+	var stack []string
+	var open []*xmlElement
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			names.declare(t.Attr)
+			name := names.name(t.Name)
+			stack = append(stack, name)
+
+			if len(open) == 0 && !matchRecordPath(stack, segments, anyDepth) {
+				continue
+			}
+			e := &xmlElement{name: name}
+			for _, attr := range t.Attr {
+				if attr.Name.Space == "xmlns" || (attr.Name.Space == "" && attr.Name.Local == "xmlns") {
+					continue
+				}
+				e.attrs = append(e.attrs, [2]string{names.name(attr.Name), attr.Value})
+			}
+			if len(open) > 0 {
+				parent := open[len(open)-1]
+				parent.children = append(parent.children, e)
+			}
+			open = append(open, e)
+
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+			if len(open) == 0 {
+				continue
+			}
+			record := open[0]
+			open = open[:len(open)-1]
+			if len(open) > 0 {
+				continue
+			}
+
+			row := make(map[string]string)
+			flattenElement(record, "", func(key, value string) {
+				if existing, ok := row[key]; ok {
+					// Repeated sibling elements are joined into one cell
+					row[key] = existing + "; " + value
+					return
+				}
+				row[key] = value
+				if !seen[key] {
+					seen[key] = true
+					headers = append(headers, key)
+				}
+			})
+			rows = append(rows, row)
+
+		case xml.CharData:
+			if len(open) > 0 {
+				open[len(open)-1].text.Write(t)
+			}
+		}
+	}
+
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("no records found at %s in XML data", path)
+	}
+
+	return &TableData{
+		Headers:    headers,
+		Rows:       rows,
+		Namespaces: names.namespaces(),
+	}, nil
+}
+
+// flattenElement reports every attribute and leaf element of a record,
+// naming nested elements after their path from the record
+func flattenElement(e *xmlElement, prefix string, add func(key, value string)) {
+	for _, attr := range e.attrs {
+		add(prefix+"@"+attr[0], attr[1])
+	}
+
+	text := strings.TrimSpace(e.text.String())
+	if len(e.children) == 0 {
+		if prefix == "" {
+			if text != "" {
+				add("#text", text)
+			}
+			return
+		}
+		add(strings.TrimSuffix(prefix, "."), text)
+		return
+	}
+
+	if text != "" {
+		add(prefix+"#text", text)
+	}
+	for _, child := range e.children {
+		flattenElement(child, prefix+child.name+".", add)
+	}
+}
+
+// wrapsOnly reports whether the elements from outer down to inner hold no
+// other elements than the next one on the way to inner
+func wrapsOnly(order []string, outer, inner string) bool {
+	if !strings.HasPrefix(inner, outer+"/") {
+		return false
+	}
+	for _, path := range order {
+		if !strings.HasPrefix(path, outer+"/") || strings.HasPrefix(inner+"/", path+"/") {
+			continue
+		}
+		// An element beside one of the steps down to inner
+		parent := path[:strings.LastIndex(path, "/")]
+		if strings.HasPrefix(inner, parent+"/") && parent != inner {
+			return false
+		}
+	}
+	return true
+}
+
+// detectRecordPath finds the shallowest element that repeats under the same
+// parent path, falling back to the children of the root element. A root
+// with a single child element is taken as holding a single record.
+func detectRecordPath(input []byte) (string, error) {
+	dec := newXMLDecoder(input)
+	names := &xmlNames{prefixes: make(map[string]string)}
+
+	counts := make(map[string]int)
+	var order []string
+	var stack []string
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			names.declare(t.Attr)
+			stack = append(stack, names.name(t.Name))
+			path := "/" + strings.Join(stack, "/")
+			if counts[path] == 0 {
+				order = append(order, path)
+			}
+			counts[path]++
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	if len(order) == 0 {
+		return "", fmt.Errorf("empty XML data")
+	}
+
+	best := ""
+	for _, path := range order {
+		if counts[path] < 2 {
+			continue
+		}
+		depth, bestDepth := strings.Count(path, "/"), strings.Count(best, "/")
+		if best == "" || depth < bestDepth || (depth == bestDepth && counts[path] > counts[best]) {
+			best = path
+		}
+	}
+	// A root with a single child element holds one record, such as one with
+	// a list inside it, unless that child only wraps the repeated elements
+	var top []string
+	for _, path := range order {
+		if strings.Count(path, "/") == 2 {
+			top = append(top, path)
+		}
+	}
+	if len(top) == 1 && counts[top[0]] == 1 && (best == "" || !wrapsOnly(order, top[0], best)) {
+		return top[0], nil
+	}
+	if best != "" {
+		return best, nil
+	}
+
+	// A single record: use whatever sits directly under the root
+	for _, path := range order {
+		if strings.Count(path, "/") == 2 {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("no records found in XML data")
+}
+
+func splitRecordPath(path string) ([]string, bool) {
+	anyDepth := strings.HasPrefix(path, "//")
+	var segments []string
+	for _, segment := range strings.Split(strings.Trim(path, "/"), "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	return segments, anyDepth
+}
+
+// matchRecordPath reports whether the open element stack ends at a record.
+// Segments without a prefix match elements from any namespace.
+func matchRecordPath(stack, segments []string, anyDepth bool) bool {
+	if len(segments) == 0 || len(stack) < len(segments) || (!anyDepth && len(stack) != len(segments)) {
+		return false
+	}
+	offset := len(stack) - len(segments)
+	for i, segment := range segments {
+		name := stack[offset+i]
+		if segment == "*" || segment == name {
+			continue
+		}
+		if !strings.Contains(segment, ":") {
+			if _, local, ok := strings.Cut(name, ":"); ok && local == segment {
+				continue
+			}
+		}
+		return false
+	}
+	return true
+}
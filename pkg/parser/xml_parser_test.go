@@ -0,0 +1,123 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestXMLParser_Parse(t *testing.T) {
+	catalog := `<?xml version="1.0"?>
+<catalog>
+	<book id="1">
+		<title>Go</title>
+		<price currency="USD">30</price>
+		<author><name>Alan</name><country>US</country></author>
+	</book>
+	<book id="2">
+		<title>XML</title>
+		<price currency="EUR">25</price>
+	</book>
+</catalog>`
+
+	tests := []struct {
+		name    string
+		parser  *XMLParser
+		input   string
+		want    *TableData
+		wantErr bool
+	}{
+		{
+			name:   "Record Path",
+			parser: &XMLParser{RecordPath: "/catalog/book"},
+			input:  catalog,
+			want: &TableData{
+				Headers: []string{"@id", "title", "price.@currency", "price", "author.name", "author.country"},
+				Rows: []map[string]string{
+					{"@id": "1", "title": "Go", "price.@currency": "USD", "price": "30", "author.name": "Alan", "author.country": "US"},
+					{"@id": "2", "title": "XML", "price.@currency": "EUR", "price": "25"},
+				},
+			},
+		},
+		{
+			name:   "Detected Path",
+			parser: &XMLParser{},
+			input:  `<data><meta><v>1</v></meta><rows><row><a>1</a></row><row><a>2</a></row></rows></data>`,
+			want: &TableData{
+				Headers: []string{"a"},
+				Rows: []map[string]string{
+					{"a": "1"},
+					{"a": "2"},
+				},
+			},
+		},
+		{
+			name:   "Single Record With A List",
+			parser: &XMLParser{},
+			input:  `<config><server><name>web</name><ports><port>80</port><port>443</port></ports></server></config>`,
+			want: &TableData{
+				Headers: []string{"name", "ports.port"},
+				Rows:    []map[string]string{{"name": "web", "ports.port": "80; 443"}},
+			},
+		},
+		{
+			name:   "Records In A Wrapper",
+			parser: &XMLParser{},
+			input:  `<catalog><books><book><t>a</t></book><book><t>b</t></book></books></catalog>`,
+			want: &TableData{
+				Headers: []string{"t"},
+				Rows:    []map[string]string{{"t": "a"}, {"t": "b"}},
+			},
+		},
+		{
+			name:   "Any Depth And Repeated Elements",
+			parser: &XMLParser{RecordPath: "//item"},
+			input:  `<r><g><item><tag>x</tag><tag>y</tag></item></g><g><item><tag>z</tag></item></g></r>`,
+			want: &TableData{
+				Headers: []string{"tag"},
+				Rows: []map[string]string{
+					{"tag": "x; y"},
+					{"tag": "z"},
+				},
+			},
+		},
+		{
+			name:   "Namespaces",
+			parser: &XMLParser{RecordPath: "/feed/entry"},
+			input: `<feed xmlns="http://www.w3.org/2005/Atom" xmlns:dc="http://purl.org/dc/elements/1.1/">
+				<entry><title>A</title><dc:title>B</dc:title></entry>
+			</feed>`,
+			want: &TableData{
+				Headers: []string{"title", "dc:title"},
+				Rows: []map[string]string{
+					{"title": "A", "dc:title": "B"},
+				},
+				Namespaces: map[string]string{"dc": "http://purl.org/dc/elements/1.1/"},
+			},
+		},
+		{
+			name:    "No Matching Records",
+			parser:  &XMLParser{RecordPath: "/catalog/magazine"},
+			input:   catalog,
+			wantErr: true,
+		},
+		{
+			name:    "Invalid XML",
+			parser:  &XMLParser{},
+			input:   `<catalog><book>`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parser.Parse([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("XMLParser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("XMLParser.Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
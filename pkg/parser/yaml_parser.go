@@ -0,0 +1,226 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// YAMLParser implements Parser for YAML input, mapping it onto records the
+// same way JSONParser does. A stream of several documents is read as one
+// table: each document contributes the records it would hold on its own,
+// or a single record if it holds no table.
+type YAMLParser struct {
+	// Path and KeyColumn work as they do for JSONParser
+	Path      string
+	KeyColumn string
+	FlattenOptions
+}
+
+func (p *YAMLParser) Parse(input []byte) (*TableData, error) {
+	input, err := Decode(input, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var documents []any
+	dec := yaml.NewDecoder(bytes.NewReader(input))
+	for {
+		var node yaml.Node
+		err := dec.Decode(&node)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		value, err := yamlValue(&node)
+		if err != nil {
+			return nil, err
+		}
+		if value != nil {
+			documents = append(documents, value)
+		}
+	}
+	if len(documents) == 0 {
+		return nil, fmt.Errorf("empty YAML input")
+	}
+
+	var records []any
+	for _, document := range documents {
+		value := document
+		if p.Path != "" {
+			if value, err = evalPath(value, p.Path); err != nil {
+				return nil, err
+			}
+		} else {
+			value = unwrapRecords(value)
+		}
+		items, err := tableRecords(value, p.KeyColumn)
+		if err != nil {
+			if len(documents) == 1 {
+				return nil, fmt.Errorf("YAML input: %v", err)
+			}
+			// In a stream, a document holding no table is a single record
+			items = []any{value}
+		}
+		records = append(records, items...)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("no YAML records found")
+	}
+
+	return recordTable(records, p.FlattenOptions)
+}
+
+// unwrapRecords finds the list of records in a configuration document: the
+// only array of objects among its keys, or the array under its only key
+func unwrapRecords(value any) any {
+	obj, ok := value.(*object)
+	if !ok {
+		return value
+	}
+
+	var found []any
+	for _, key := range obj.keys {
+		items, ok := obj.values[key].([]any)
+		if !ok || len(items) == 0 || !isObjectArray(items) {
+			continue
+		}
+		if found != nil {
+			return value
+		}
+		found = items
+	}
+	if found != nil {
+		return found
+	}
+
+	if len(obj.keys) == 1 {
+		if items, ok := obj.values[obj.keys[0]].([]any); ok {
+			return items
+		}
+	}
+	return value
+}
+
+func isObjectArray(items []any) bool {
+	for _, item := range items {
+		if !isObject(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// yamlValue converts a YAML node to the record model, keeping key order and
+// scalar types. Anchors are resolved and << merge keys applied.
+func yamlValue(node *yaml.Node) (any, error) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil
+		}
+		return yamlValue(node.Content[0])
+	case yaml.AliasNode:
+		return yamlValue(node.Alias)
+	case yaml.SequenceNode:
+		items := make([]any, 0, len(node.Content))
+		for _, child := range node.Content {
+			item, err := yamlValue(child)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	case yaml.MappingNode:
+		obj := newObject()
+		var merged []*object
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, valueNode := node.Content[i], node.Content[i+1]
+			value, err := yamlValue(valueNode)
+			if err != nil {
+				return nil, err
+			}
+			if key.Tag == "!!merge" {
+				switch v := value.(type) {
+				case *object:
+					merged = append(merged, v)
+				case []any:
+					for _, item := range v {
+						if m, ok := item.(*object); ok {
+							merged = append(merged, m)
+						}
+					}
+				}
+				continue
+			}
+			obj.set(key.Value, value)
+		}
+		// Keys set in the mapping itself win over merged ones
+		for _, m := range merged {
+			for _, key := range m.keys {
+				if _, ok := obj.values[key]; !ok {
+					obj.set(key, m.values[key])
+				}
+			}
+		}
+		return obj, nil
+	case yaml.ScalarNode:
+		return yamlScalar(node)
+	}
+	return nil, fmt.Errorf("line %d: unsupported YAML node", node.Line)
+}
+
+func yamlScalar(node *yaml.Node) (any, error) {
+	switch node.ShortTag() {
+	case "!!null":
+		return nil, nil
+	case "!!bool":
+		var b bool
+		if err := node.Decode(&b); err != nil {
+			return nil, err
+		}
+		return b, nil
+	case "!!int":
+		var n int64
+		if err := node.Decode(&n); err != nil {
+			// Too large for int64: keep the digits as written
+			return node.Value, nil
+		}
+		return json.Number(strconv.FormatInt(n, 10)), nil
+	case "!!float":
+		var f float64
+		if err := node.Decode(&f); err != nil {
+			return nil, err
+		}
+		return floatNumber(f), nil
+	case "!!timestamp":
+		// Kept as written; only the kind tells a date from a timestamp
+		if strings.ContainsAny(node.Value, "Tt ") {
+			return temporal{node.Value, TypeTimestamp}, nil
+		}
+		return temporal{node.Value, TypeDate}, nil
+	}
+	return node.Value, nil
+}
+
+// floatNumber formats a float so it still reads as one, keeping infinities
+// and NaN, which JSON numbers cannot hold, as text
+func floatNumber(f float64) any {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	}
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".eE") {
+		s += ".0"
+	}
+	return json.Number(s)
+}
@@ -0,0 +1,150 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestYAMLParser_Parse(t *testing.T) {
+	tests := []struct {
+		name    string
+		parser  *YAMLParser
+		input   string
+		want    *TableData
+		wantErr bool
+	}{
+		{
+			name:   "Sequence Of Mappings",
+			parser: &YAMLParser{},
+			input: `- name: John
+  age: 30
+  score: 1.0
+  active: true
+- name: "007"
+  age: null
+  score: 2.5
+`,
+			want: &TableData{
+				Headers: []string{"name", "age", "score", "active"},
+				Rows: []map[string]string{
+					{"name": "John", "age": "30", "score": "1.0", "active": "true"},
+					{"name": "007", "score": "2.5"},
+				},
+				Types: map[string]ColumnType{
+					"age":    TypeInteger,
+					"score":  TypeFloat,
+					"active": TypeBoolean,
+				},
+			},
+		},
+		{
+			name:   "Records Under A Key",
+			parser: &YAMLParser{},
+			input: `version: 2
+defaults: &defaults
+  region: eu
+users:
+  - <<: *defaults
+    name: Zed
+  - <<: *defaults
+    name: Amy
+    region: us
+`,
+			want: &TableData{
+				Headers: []string{"name", "region"},
+				Rows: []map[string]string{
+					{"name": "Zed", "region": "eu"},
+					{"name": "Amy", "region": "us"},
+				},
+			},
+		},
+		{
+			name:   "Multiple Documents",
+			parser: &YAMLParser{},
+			input: `---
+id: 1
+---
+- id: 2
+- id: 3
+`,
+			want: &TableData{
+				Headers: []string{"id"},
+				Rows: []map[string]string{
+					{"id": "1"},
+					{"id": "2"},
+					{"id": "3"},
+				},
+				Types: map[string]ColumnType{"id": TypeInteger},
+			},
+		},
+		{
+			name:   "Multiple Documents With Records Under A Key",
+			parser: &YAMLParser{},
+			input: `servers:
+  - name: a
+    n: 1
+---
+servers:
+  - name: b
+    n: 2
+---
+web: {ip: 10.0.0.1}
+`,
+			want: &TableData{
+				Headers: []string{"name", "n", "key", "ip"},
+				Rows: []map[string]string{
+					{"name": "a", "n": "1"},
+					{"name": "b", "n": "2"},
+					{"key": "web", "ip": "10.0.0.1"},
+				},
+				Types: map[string]ColumnType{"n": TypeInteger},
+			},
+		},
+		{
+			name:   "Path",
+			parser: &YAMLParser{Path: "$.data.items"},
+			input: `data:
+  items:
+    - b: x
+      a: y
+`,
+			want: &TableData{
+				Headers: []string{"b", "a"},
+				Rows:    []map[string]string{{"b": "x", "a": "y"}},
+			},
+		},
+		{
+			name:    "Invalid YAML",
+			parser:  &YAMLParser{},
+			input:   "- a: [1, 2",
+			wantErr: true,
+		},
+		{
+			name:    "Empty Input",
+			parser:  &YAMLParser{},
+			input:   "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parser.Parse([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("YAMLParser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got.Headers, tt.want.Headers) || !reflect.DeepEqual(got.Rows, tt.want.Rows) {
+				t.Errorf("YAMLParser.Parse() = %v %v, want %v %v", got.Headers, got.Rows, tt.want.Headers, tt.want.Rows)
+			}
+			for h, kind := range tt.want.Types {
+				if got.ColumnType(h) != kind {
+					t.Errorf("YAMLParser.Parse() type of %s = %v, want %v", h, got.ColumnType(h), kind)
+				}
+			}
+		})
+	}
+}
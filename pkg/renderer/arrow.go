@@ -0,0 +1,149 @@
+package renderer
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+// arrowSchema builds a schema of nullable columns, typed as for
+// SQLiteRenderer, with dates, times and timestamps stored as such to the
+// microsecond. A column holding a value that does not fit its type is
+// stored as strings so nothing is lost.
+func arrowSchema(data *parser.TableData) (*arrow.Schema, []parser.ColumnType) {
+	fields := make([]arrow.Field, len(data.Headers))
+	kinds := make([]parser.ColumnType, len(data.Headers))
+	for i, h := range data.Headers {
+		kind := inferColumnType(data, h)
+		zoned := map[bool]bool{}
+		for _, row := range data.Rows {
+			value := row[h]
+			if value == "" {
+				continue
+			}
+			if arrowValue(kind, value) == nil {
+				kind = parser.TypeString
+				break
+			}
+			if kind == parser.TypeTimestamp {
+				_, zone, _ := parseTimestamp(value)
+				zoned[zone] = true
+			}
+		}
+		// A column takes one kind of timestamp, with or without a zone
+		if len(zoned) > 1 {
+			kind = parser.TypeString
+		}
+		kinds[i] = kind
+
+		var dataType arrow.DataType = arrow.BinaryTypes.String
+		switch kind {
+		case parser.TypeInteger:
+			dataType = arrow.PrimitiveTypes.Int64
+		case parser.TypeFloat:
+			dataType = arrow.PrimitiveTypes.Float64
+		case parser.TypeBoolean:
+			dataType = arrow.FixedWidthTypes.Boolean
+		case parser.TypeDate:
+			dataType = arrow.FixedWidthTypes.Date32
+		case parser.TypeTime:
+			dataType = arrow.FixedWidthTypes.Time64us
+		case parser.TypeTimestamp:
+			if zoned[true] {
+				dataType = arrow.FixedWidthTypes.Timestamp_us
+			} else {
+				dataType = &arrow.TimestampType{Unit: arrow.Microsecond}
+			}
+		}
+		fields[i] = arrow.Field{Name: h, Type: dataType, Nullable: true}
+	}
+	return arrow.NewSchema(fields, nil), kinds
+}
+
+// arrowValue parses a cell for a column of the given type, returning nil
+// when it does not fit
+func arrowValue(kind parser.ColumnType, value string) any {
+	switch kind {
+	case parser.TypeInteger:
+		if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return n
+		}
+	case parser.TypeFloat:
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+	case parser.TypeBoolean:
+		if value == "true" || value == "false" {
+			return value == "true"
+		}
+	case parser.TypeDate:
+		if t, err := time.Parse(time.DateOnly, value); err == nil {
+			return arrow.Date32FromTime(t)
+		}
+	case parser.TypeTime:
+		// Times and timestamps finer than a microsecond stay text
+		if t, err := time.Parse("15:04:05.999999999", value); err == nil && t.Nanosecond()%1000 == 0 {
+			clock := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute +
+				time.Duration(t.Second())*time.Second + time.Duration(t.Nanosecond())
+			return arrow.Time64(clock / time.Microsecond)
+		}
+	case parser.TypeTimestamp:
+		if t, _, ok := parseTimestamp(value); ok && t.Nanosecond()%1000 == 0 {
+			return arrow.Timestamp(t.UnixMicro())
+		}
+	default:
+		return value
+	}
+	return nil
+}
+
+// parseTimestamp reads an ISO 8601 timestamp, reporting whether it carries
+// a zone offset. Wall clock times are read as if they were UTC.
+func parseTimestamp(value string) (time.Time, bool, bool) {
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return t, true, true
+	}
+	if t, err := time.Parse("2006-01-02T15:04:05.999999999", value); err == nil {
+		return t, false, true
+	}
+	return time.Time{}, false, false
+}
+
+// arrowRecord builds a record batch from rows. Missing cells, and empty
+// ones in typed columns, are null.
+func arrowRecord(schema *arrow.Schema, kinds []parser.ColumnType, headers []string, rows []map[string]string) arrow.Record {
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer builder.Release()
+
+	for i, h := range headers {
+		field := builder.Field(i)
+		for _, row := range rows {
+			value, ok := row[h]
+			if !ok || (value == "" && kinds[i] != parser.TypeString) {
+				field.AppendNull()
+				continue
+			}
+			switch b := field.(type) {
+			case *array.Int64Builder:
+				b.Append(arrowValue(kinds[i], value).(int64))
+			case *array.Float64Builder:
+				b.Append(arrowValue(kinds[i], value).(float64))
+			case *array.BooleanBuilder:
+				b.Append(arrowValue(kinds[i], value).(bool))
+			case *array.Date32Builder:
+				b.Append(arrowValue(kinds[i], value).(arrow.Date32))
+			case *array.Time64Builder:
+				b.Append(arrowValue(kinds[i], value).(arrow.Time64))
+			case *array.TimestampBuilder:
+				b.Append(arrowValue(kinds[i], value).(arrow.Timestamp))
+			case *array.StringBuilder:
+				b.Append(value)
+			}
+		}
+	}
+	return builder.NewRecord()
+}
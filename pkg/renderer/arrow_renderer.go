@@ -0,0 +1,79 @@
+package renderer
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+// ArrowRenderer implements Renderer for Apache Arrow IPC data, written in
+// the file format, which Feather version 2 readers also accept, or the
+// stream format. Column types come from the data as for SQLiteRenderer.
+type ArrowRenderer struct {
+	// Stream writes the stream format instead of the file format
+	Stream bool
+	// Compression names the buffer codec: none (default), lz4 or zstd
+	Compression string
+	// BatchSize is the most rows per record batch, 65536 by default
+	BatchSize int
+}
+
+// arrowWriter is implemented by both IPC writers
+type arrowWriter interface {
+	Write(arrow.Record) error
+	Close() error
+}
+
+func (r *ArrowRenderer) Render(data *parser.TableData) (string, error) {
+	data = withFooter(data)
+	var opts []ipc.Option
+	switch strings.ToLower(strings.TrimSpace(r.Compression)) {
+	case "", "none", "uncompressed":
+	case "lz4", "lz4_frame":
+		opts = append(opts, ipc.WithLZ4())
+	case "zstd":
+		opts = append(opts, ipc.WithZstd())
+	default:
+		return "", fmt.Errorf("unknown compression %q: use lz4, zstd or none", r.Compression)
+	}
+	if len(data.Headers) == 0 {
+		return "", fmt.Errorf("no columns to write")
+	}
+	batch := r.BatchSize
+	if batch <= 0 {
+		batch = 65536
+	}
+
+	schema, kinds := arrowSchema(data)
+	opts = append(opts, ipc.WithSchema(schema))
+
+	var buf bytes.Buffer
+	var writer arrowWriter
+	if r.Stream {
+		writer = ipc.NewWriter(&buf, opts...)
+	} else {
+		fw, err := ipc.NewFileWriter(&buf, opts...)
+		if err != nil {
+			return "", err
+		}
+		writer = fw
+	}
+
+	for start := 0; start < len(data.Rows); start += batch {
+		record := arrowRecord(schema, kinds, data.Headers, data.Rows[start:min(start+batch, len(data.Rows))])
+		err := writer.Write(record)
+		record.Release()
+		if err != nil {
+			writer.Close()
+			return "", err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
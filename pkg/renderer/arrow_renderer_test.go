@@ -0,0 +1,124 @@
+package renderer
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+func TestArrowRenderer_Render(t *testing.T) {
+	data := &parser.TableData{
+		Headers: []string{"id", "name", "ok"},
+		Rows: []map[string]string{
+			{"id": "1", "name": "John", "ok": "true"},
+			{"id": "2", "ok": "false"},
+			{"id": "3", "name": ""},
+		},
+		Types: map[string]parser.ColumnType{"ok": parser.TypeBoolean},
+	}
+	want := []map[string]string{
+		{"id": "1", "name": "John", "ok": "true"},
+		{"id": "2", "ok": "false"},
+		{"id": "3", "name": ""},
+	}
+	wantTypes := map[string]parser.ColumnType{"id": parser.TypeInteger, "ok": parser.TypeBoolean}
+
+	tests := []struct {
+		name     string
+		renderer *ArrowRenderer
+	}{
+		{"File", &ArrowRenderer{BatchSize: 2}},
+		{"File LZ4", &ArrowRenderer{Compression: "lz4", BatchSize: 2}},
+		{"Stream Zstd", &ArrowRenderer{Stream: true, Compression: "zstd", BatchSize: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := tt.renderer.Render(data)
+			if err != nil {
+				t.Fatalf("ArrowRenderer.Render() error = %v", err)
+			}
+
+			if !tt.renderer.Stream {
+				fr, err := ipc.NewFileReader(bytes.NewReader([]byte(output)))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if batches := fr.NumRecords(); batches != 2 {
+					t.Errorf("record batches = %d, want 2", batches)
+				}
+				fr.Close()
+			}
+
+			got, err := (&parser.ArrowParser{}).Parse([]byte(output))
+			if err != nil {
+				t.Fatalf("ArrowParser.Parse() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.Rows, want) || !reflect.DeepEqual(got.Types, wantTypes) {
+				t.Errorf("round trip = %v %v, want %v %v", got.Rows, got.Types, want, wantTypes)
+			}
+		})
+	}
+
+	if _, err := (&ArrowRenderer{Compression: "snappy"}).Render(data); err == nil {
+		t.Errorf("ArrowRenderer.Render() with an unknown codec should fail")
+	}
+}
+
+func TestArrowRenderer_Temporal(t *testing.T) {
+	data := &parser.TableData{
+		Headers: []string{"day", "at", "local", "clock", "mixed", "fine"},
+		Rows: []map[string]string{
+			{"day": "2024-02-29", "at": "2024-02-29T10:30:00+02:00", "local": "2024-02-29T10:30:00.5", "clock": "23:59:59.25", "mixed": "2024-02-29T10:30:00Z", "fine": "10:00:00.000000001"},
+			{"local": "", "mixed": "2024-02-29T10:30:00"},
+		},
+		Types: map[string]parser.ColumnType{
+			"day":   parser.TypeDate,
+			"at":    parser.TypeTimestamp,
+			"local": parser.TypeTimestamp,
+			"clock": parser.TypeTime,
+			"mixed": parser.TypeTimestamp,
+			"fine":  parser.TypeTime,
+		},
+	}
+	// Offsets become UTC; a column mixing zoned and wall clock timestamps,
+	// or holding nanoseconds, is stored as text
+	want := []map[string]string{
+		{"day": "2024-02-29", "at": "2024-02-29T08:30:00Z", "local": "2024-02-29T10:30:00.5", "clock": "23:59:59.25", "mixed": "2024-02-29T10:30:00Z", "fine": "10:00:00.000000001"},
+		{"mixed": "2024-02-29T10:30:00"},
+	}
+	wantTypes := map[string]parser.ColumnType{
+		"day":   parser.TypeDate,
+		"at":    parser.TypeTimestamp,
+		"local": parser.TypeTimestamp,
+		"clock": parser.TypeTime,
+	}
+
+	tests := []struct {
+		name     string
+		renderer Renderer
+		parser   parser.Parser
+	}{
+		{"Arrow", &ArrowRenderer{}, &parser.ArrowParser{}},
+		{"Parquet", &ParquetRenderer{}, &parser.ParquetParser{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := tt.renderer.Render(data)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			got, err := tt.parser.Parse([]byte(output))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.Rows, want) || !reflect.DeepEqual(got.Types, wantTypes) {
+				t.Errorf("round trip = %v %v, want %v %v", got.Rows, got.Types, want, wantTypes)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package renderer
+
+import (
+	"strings"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+// AsciiDocRenderer implements Renderer for AsciiDoc tables. Column
+// alignment goes in the cols attribute, numeric columns are right aligned
+// and line breaks inside cells become hard line breaks.
+type AsciiDocRenderer struct {
+	// NoHeader leaves out the header row
+	NoHeader bool
+	// Caption is the block title and Label the ID of the table
+	Caption string
+	Label   string
+}
+
+func (r *AsciiDocRenderer) Render(data *parser.TableData) (string, error) {
+	layout := newTextLayout(data, asciidocEscape, true)
+
+	var result strings.Builder
+	if r.Label != "" {
+		result.WriteString("[[" + r.Label + "]]\n")
+	}
+	if r.Caption != "" {
+		result.WriteString("." + strings.ReplaceAll(r.Caption, "\n", " ") + "\n")
+	}
+
+	cols := make([]string, len(layout.align))
+	for i, align := range layout.align {
+		switch align {
+		case parser.AlignCenter:
+			cols[i] = "^"
+		case parser.AlignRight:
+			cols[i] = ">"
+		default:
+			cols[i] = "<"
+		}
+	}
+	var options []string
+	if !r.NoHeader {
+		options = append(options, "header")
+	}
+	// The footer option only marks the last row
+	if len(layout.footer) == 1 {
+		options = append(options, "footer")
+	}
+	result.WriteString(`[cols="` + strings.Join(cols, ",") + `"`)
+	if len(options) > 0 {
+		result.WriteString(`,options="` + strings.Join(options, ",") + `"`)
+	}
+	result.WriteString("]\n|===\n")
+
+	writeRow := func(cells [][]string) {
+		multiline := false
+		for _, cell := range cells {
+			multiline = multiline || len(cell) > 1
+		}
+		if !multiline {
+			line := layout.lines(cells, "|", "|", "")[0]
+			result.WriteString(strings.TrimRight(line, " ") + "\n")
+			return
+		}
+
+		// Cells with several lines go on lines of their own
+		for _, cell := range cells {
+			lines := make([]string, len(cell))
+			for i, line := range cell {
+				if line == "" {
+					line = "{empty}"
+				}
+				lines[i] = line
+			}
+			result.WriteString("| " + strings.Join(lines, " +\n") + "\n")
+		}
+	}
+
+	if !r.NoHeader {
+		writeRow(layout.header)
+		result.WriteString("\n")
+	}
+	for _, cells := range layout.rows {
+		writeRow(cells)
+	}
+	for _, cells := range layout.footer {
+		writeRow(cells)
+	}
+	result.WriteString("|===\n")
+
+	return result.String(), nil
+}
+
+// asciidocEscape escapes the cell separator
+func asciidocEscape(value string) string {
+	return strings.ReplaceAll(value, "|", `\|`)
+}
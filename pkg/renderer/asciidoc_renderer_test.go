@@ -0,0 +1,62 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+func TestAsciiDocRenderer_Render(t *testing.T) {
+	data := &parser.TableData{
+		Headers: []string{"item", "qty", "note"},
+		Rows: []map[string]string{
+			{"item": "a|b", "qty": "2", "note": "ok"},
+			{"item": "c", "qty": "10", "note": "one\n\ntwo"},
+		},
+		Footer: []map[string]string{{"item": "Total", "qty": "12"}},
+		Types:  map[string]parser.ColumnType{"qty": parser.TypeInteger},
+		Align:  map[string]parser.Alignment{"note": parser.AlignCenter},
+	}
+
+	tests := []struct {
+		name     string
+		renderer *AsciiDocRenderer
+		want     string
+	}{
+		{
+			name:     "Header And Footer",
+			renderer: &AsciiDocRenderer{Caption: "Stock", Label: "stock"},
+			want: "[[stock]]\n" +
+				".Stock\n" +
+				"[cols=\"<,>,^\",options=\"header,footer\"]\n" +
+				"|===\n" +
+				"| item  | qty | note\n\n" +
+				"| a\\|b  |   2 |  ok\n" +
+				"| c\n| 10\n| one +\n{empty} +\ntwo\n" +
+				"| Total |  12 |\n" +
+				"|===\n",
+		},
+		{
+			name:     "No Header",
+			renderer: &AsciiDocRenderer{NoHeader: true},
+			want: "[cols=\"<,>,^\",options=\"footer\"]\n" +
+				"|===\n" +
+				"| a\\|b  |   2 |  ok\n" +
+				"| c\n| 10\n| one +\n{empty} +\ntwo\n" +
+				"| Total |  12 |\n" +
+				"|===\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.renderer.Render(data)
+			if err != nil {
+				t.Fatalf("AsciiDocRenderer.Render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("AsciiDocRenderer.Render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
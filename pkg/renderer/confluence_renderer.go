@@ -0,0 +1,97 @@
+package renderer
+
+import (
+	"html"
+	"strings"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+// ConfluenceRenderer implements Renderer for the Confluence storage format,
+// the XHTML Confluence keeps pages in and its REST API takes. Footer rows
+// are written with header cells, as Confluence tables have no footer.
+type ConfluenceRenderer struct{}
+
+func (r *ConfluenceRenderer) Render(data *parser.TableData) (string, error) {
+	var result strings.Builder
+
+	paragraph := make([]string, len(data.Headers))
+	for i, h := range data.Headers {
+		paragraph[i] = "<p>"
+		switch columnAlign(data, h, true) {
+		case parser.AlignCenter:
+			paragraph[i] = `<p style="text-align: center;">`
+		case parser.AlignRight:
+			paragraph[i] = `<p style="text-align: right;">`
+		}
+	}
+
+	result.WriteString("<table>\n<tbody>\n<tr>\n")
+	for _, h := range data.Headers {
+		result.WriteString("<th><p>" + confluenceEscape(h) + "</p></th>\n")
+	}
+	result.WriteString("</tr>\n")
+
+	writeRow := func(cells []string, tag string) {
+		result.WriteString("<tr>\n")
+		for i, cell := range cells {
+			result.WriteString("<" + tag + ">" + paragraph[i] + cell + "</p></" + tag + ">\n")
+		}
+		result.WriteString("</tr>\n")
+	}
+	for i, row := range data.Rows {
+		cells := make([]string, len(data.Headers))
+		for j, h := range data.Headers {
+			cells[j] = confluenceEscape(row[h])
+			if rt, ok := data.RichCell(i, h); ok {
+				cells[j] = richConfluence(rt)
+			}
+		}
+		writeRow(cells, "td")
+	}
+	for _, row := range data.Footer {
+		cells := make([]string, len(data.Headers))
+		for j, h := range data.Headers {
+			cells[j] = confluenceEscape(row[h])
+		}
+		writeRow(cells, "th")
+	}
+	result.WriteString("</tbody>\n</table>\n")
+
+	return result.String(), nil
+}
+
+// confluenceEscape escapes text for XHTML, dropping the control characters
+// XML cannot hold and turning line breaks into <br />
+func confluenceEscape(value string) string {
+	value = strings.Map(func(r rune) rune {
+		if r < 0x20 && r != '\t' && r != '\n' || r == 0xfffe || r == 0xffff {
+			return -1
+		}
+		return r
+	}, strings.ReplaceAll(value, "\r\n", "\n"))
+	return strings.ReplaceAll(html.EscapeString(value), "\n", "<br />")
+}
+
+// richConfluence converts formatted cell content to XHTML
+func richConfluence(rt parser.RichText) string {
+	var result strings.Builder
+	for _, span := range rt {
+		text := confluenceEscape(span.Text)
+		if span.Code {
+			text = "<code>" + text + "</code>"
+		} else {
+			if span.Italic {
+				text = "<em>" + text + "</em>"
+			}
+			if span.Bold {
+				text = "<strong>" + text + "</strong>"
+			}
+		}
+		if span.Link != "" {
+			text = `<a href="` + html.EscapeString(span.Link) + `">` + text + "</a>"
+		}
+		result.WriteString(text)
+	}
+	return result.String()
+}
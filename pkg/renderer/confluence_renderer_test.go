@@ -0,0 +1,37 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+func TestConfluenceRenderer_Render(t *testing.T) {
+	data := &parser.TableData{
+		Headers: []string{"name", "qty"},
+		Rows: []map[string]string{
+			{"name": "<b> & \"c\"\nnext\x01", "qty": "2"},
+			{"name": "link"},
+		},
+		Footer: []map[string]string{{"name": "Total", "qty": "2"}},
+		Types:  map[string]parser.ColumnType{"qty": parser.TypeInteger},
+		Rich: []map[string]parser.RichText{
+			nil,
+			{"name": {{Text: "docs", Link: "https://example.com/?a=1&b=2", Italic: true}}},
+		},
+	}
+
+	got, err := (&ConfluenceRenderer{}).Render(data)
+	if err != nil {
+		t.Fatalf("ConfluenceRenderer.Render() error = %v", err)
+	}
+	want := "<table>\n<tbody>\n" +
+		"<tr>\n<th><p>name</p></th>\n<th><p>qty</p></th>\n</tr>\n" +
+		"<tr>\n<td><p>&lt;b&gt; &amp; &#34;c&#34;<br />next</p></td>\n<td><p style=\"text-align: right;\">2</p></td>\n</tr>\n" +
+		"<tr>\n<td><p><a href=\"https://example.com/?a=1&amp;b=2\"><em>docs</em></a></p></td>\n<td><p style=\"text-align: right;\"></p></td>\n</tr>\n" +
+		"<tr>\n<th><p>Total</p></th>\n<th><p style=\"text-align: right;\">2</p></th>\n</tr>\n" +
+		"</tbody>\n</table>\n"
+	if got != want {
+		t.Errorf("ConfluenceRenderer.Render() = %q, want %q", got, want)
+	}
+}
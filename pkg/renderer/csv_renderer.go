@@ -0,0 +1,80 @@
+package renderer
+
+import (
+	"strings"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+// CSVRenderer implements Renderer for CSV output and other delimited text
+// such as TSV
+type CSVRenderer struct {
+	// Delimiter separates fields, ',' by default
+	Delimiter rune
+	// Quote encloses fields that need it, '"' by default
+	Quote rune
+	// AlwaysQuote quotes every field instead of only those that need it
+	AlwaysQuote bool
+	// CRLF ends lines with \r\n, as Excel and RFC 4180 expect
+	CRLF bool
+}
+
+func (r *CSVRenderer) Render(data *parser.TableData) (string, error) {
+	data = withFooter(data)
+	var result strings.Builder
+
+	// Write headers
+	r.writeRecord(&result, data.Headers)
+
+	// Write rows
+	for _, row := range data.Rows {
+		record := make([]string, len(data.Headers))
+		for i, h := range data.Headers {
+			record[i] = row[h]
+		}
+		r.writeRecord(&result, record)
+	}
+
+	return result.String(), nil
+}
+
+func (r *CSVRenderer) writeRecord(result *strings.Builder, record []string) {
+	delimiter, quote := r.Delimiter, r.Quote
+	if delimiter == 0 {
+		delimiter = ','
+	}
+	if quote == 0 {
+		quote = '"'
+	}
+
+	for i, field := range record {
+		if i > 0 {
+			result.WriteRune(delimiter)
+		}
+		if !r.AlwaysQuote && !needsQuotes(field, delimiter, quote) {
+			result.WriteString(field)
+			continue
+		}
+		result.WriteRune(quote)
+		result.WriteString(strings.ReplaceAll(field, string(quote), string(quote)+string(quote)))
+		result.WriteRune(quote)
+	}
+
+	if r.CRLF {
+		result.WriteString("\r\n")
+	} else {
+		result.WriteString("\n")
+	}
+}
+
+// needsQuotes follows encoding/csv: fields holding the delimiter, the quote or
+// a line break are quoted, as are fields starting with a space
+func needsQuotes(field string, delimiter, quote rune) bool {
+	if field == "" {
+		return false
+	}
+	if field == `\.` || strings.ContainsRune(field, delimiter) || strings.ContainsRune(field, quote) || strings.ContainsAny(field, "\r\n") {
+		return true
+	}
+	return field[0] == ' ' || field[0] == '\t'
+}
@@ -0,0 +1,51 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+func TestCSVRenderer_Render(t *testing.T) {
+	data := &parser.TableData{
+		Headers: []string{"name", "note"},
+		Rows: []map[string]string{
+			{"name": "John", "note": "says \"hi\""},
+			{"name": "Doe; Jane", "note": "line1\nline2"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		renderer *CSVRenderer
+		want     string
+	}{
+		{
+			name:     "Default",
+			renderer: &CSVRenderer{},
+			want:     "name,note\nJohn,\"says \"\"hi\"\"\"\nDoe; Jane,\"line1\nline2\"\n",
+		},
+		{
+			name:     "Semicolon And CRLF",
+			renderer: &CSVRenderer{Delimiter: ';', CRLF: true},
+			want:     "name;note\r\nJohn;\"says \"\"hi\"\"\"\r\n\"Doe; Jane\";\"line1\nline2\"\r\n",
+		},
+		{
+			name:     "Always Quote Tabs",
+			renderer: &CSVRenderer{Delimiter: '\t', AlwaysQuote: true},
+			want:     "\"name\"\t\"note\"\n\"John\"\t\"says \"\"hi\"\"\"\n\"Doe; Jane\"\t\"line1\nline2\"\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.renderer.Render(data)
+			if err != nil {
+				t.Fatalf("CSVRenderer.Render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("CSVRenderer.Render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package renderer
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/encoding/ianaindex"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/encoding/unicode/utf32"
+)
+
+// Encode converts rendered text to the named encoding. Besides WHATWG and
+// IANA labels such as windows-1252, it accepts utf-8-bom, which Excel needs
+// to open a CSV file as UTF-8, and utf-16, written little-endian with a BOM.
+// The -le and -be variants of UTF-16 and UTF-32 are written without a BOM.
+func Encode(output string, name string) ([]byte, error) {
+	var enc encoding.Encoding
+	switch strings.ToLower(strings.ReplaceAll(name, "_", "-")) {
+	case "", "utf-8", "utf8":
+		return []byte(output), nil
+	case "utf-8-bom", "utf-8-sig", "utf8-bom":
+		return append([]byte{0xEF, 0xBB, 0xBF}, output...), nil
+	case "utf-16", "utf16":
+		enc = unicode.UTF16(unicode.LittleEndian, unicode.UseBOM)
+	case "utf-16le":
+		enc = unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM)
+	case "utf-16be":
+		enc = unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM)
+	case "utf-32", "utf32":
+		enc = utf32.UTF32(utf32.LittleEndian, utf32.UseBOM)
+	case "utf-32le":
+		enc = utf32.UTF32(utf32.LittleEndian, utf32.IgnoreBOM)
+	case "utf-32be":
+		enc = utf32.UTF32(utf32.BigEndian, utf32.IgnoreBOM)
+	default:
+		var err error
+		if enc, err = htmlindex.Get(name); err != nil {
+			if enc, err = ianaindex.IANA.Encoding(name); err != nil || enc == nil {
+				return nil, fmt.Errorf("unsupported encoding: %s", name)
+			}
+		}
+	}
+
+	result, err := enc.NewEncoder().Bytes([]byte(output))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode output as %s: %v", name, err)
+	}
+	return result, nil
+}
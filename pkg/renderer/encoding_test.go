@@ -0,0 +1,37 @@
+package renderer
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding string
+		output   string
+		want     []byte
+		wantErr  bool
+	}{
+		{"Default", "", "café", []byte("café"), false},
+		{"UTF-8 BOM", "utf-8-bom", "a,b", []byte("\xEF\xBB\xBFa,b"), false},
+		{"UTF-16 With BOM", "utf-16", "hé", []byte{0xFF, 0xFE, 'h', 0, 0xE9, 0}, false},
+		{"UTF-16BE", "UTF-16BE", "hé", []byte{0, 'h', 0, 0xE9}, false},
+		{"Windows-1252", "windows-1252", "café €", []byte("caf\xe9 \x80"), false},
+		{"Unencodable Character", "windows-1252", "日本", nil, true},
+		{"Unsupported", "klingon", "x", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Encode(tt.output, tt.encoding)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Encode() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("Encode() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package renderer
 
 import (
 	"bytes"
+	"fmt"
+	"strings"
 
 	"github.com/gowtham2003/gotable/pkg/parser"
 	"github.com/xuri/excelize/v2"
@@ -10,12 +12,42 @@ import (
 type ExcelRenderer struct{}
 
 func (r *ExcelRenderer) Render(data *parser.TableData) (string, error) {
+	return r.RenderAll([]*parser.TableData{data})
+}
+
+// RenderAll writes each table to its own worksheet
+func (r *ExcelRenderer) RenderAll(tables []*parser.TableData) (string, error) {
 	f := excelize.NewFile()
 	defer f.Close()
 
-	// Get the default sheet name
-	sheetName := "Sheet1"
+	used := make(map[string]bool)
+	for i, data := range tables {
+		// The first table goes to the default sheet
+		sheetName := "Sheet1"
+		if len(tables) > 1 {
+			sheetName = sheetTitle(data.Name, i, used)
+			if i == 0 {
+				if err := f.SetSheetName("Sheet1", sheetName); err != nil {
+					return "", err
+				}
+			} else if _, err := f.NewSheet(sheetName); err != nil {
+				return "", err
+			}
+		}
+		r.writeSheet(f, sheetName, data)
+	}
 
+	// Save to buffer
+	var buf bytes.Buffer
+	if err := f.Write(&buf); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+func (r *ExcelRenderer) writeSheet(f *excelize.File, sheetName string, data *parser.TableData) {
+	data = withFooter(data)
 	// Write headers
 	for col, header := range data.Headers {
 		cell, _ := excelize.CoordinatesToCellName(col+1, 1)
@@ -27,6 +59,9 @@ func (r *ExcelRenderer) Render(data *parser.TableData) (string, error) {
 		for colIdx, header := range data.Headers {
 			cell, _ := excelize.CoordinatesToCellName(colIdx+1, rowIdx+2)
 			f.SetCellValue(sheetName, cell, row[header])
+			if rt, ok := data.RichCell(rowIdx, header); ok {
+				r.formatCell(f, sheetName, cell, rt)
+			}
 		}
 	}
 
@@ -51,12 +86,54 @@ func (r *ExcelRenderer) Render(data *parser.TableData) (string, error) {
 		colName, _ := excelize.ColumnNumberToName(col)
 		f.SetColWidth(sheetName, colName, colName, 15)
 	}
+}
 
-	// Save to buffer
-	var buf bytes.Buffer
-	if err := f.Write(&buf); err != nil {
-		return "", err
+// formatCell turns the first link of a rich cell into a hyperlink and wraps
+// cells containing line breaks
+func (r *ExcelRenderer) formatCell(f *excelize.File, sheetName, cell string, rt parser.RichText) {
+	style := &excelize.Style{}
+	if link := rt.Link(); link != "" {
+		linkType := "External"
+		if strings.HasPrefix(link, "#") {
+			linkType, link = "Location", strings.TrimPrefix(link, "#")
+		}
+		if err := f.SetCellHyperLink(sheetName, cell, link, linkType); err != nil {
+			return
+		}
+		style.Font = &excelize.Font{Color: "#0563C1", Underline: "single"}
+	}
+	if strings.Contains(rt.String(), "\n") {
+		style.Alignment = &excelize.Alignment{WrapText: true, Vertical: "top"}
+	}
+	if style.Font == nil && style.Alignment == nil {
+		return
+	}
+	if id, err := f.NewStyle(style); err == nil {
+		f.SetCellStyle(sheetName, cell, cell, id)
 	}
+}
 
-	return buf.String(), nil
+// sheetTitle turns a table name into a unique, valid worksheet name
+func sheetTitle(name string, index int, used map[string]bool) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`[]:*?/\`, r) {
+			return '_'
+		}
+		return r
+	}, strings.TrimSpace(name))
+	if name == "" {
+		name = fmt.Sprintf("Table %d", index+1)
+	}
+	if runes := []rune(name); len(runes) > 31 {
+		name = string(runes[:31])
+	}
+
+	title := name
+	for n := 2; used[strings.ToLower(title)]; n++ {
+		suffix := fmt.Sprintf(" (%d)", n)
+		runes := []rune(name)
+		title = string(runes[:min(len(runes), 31-len(suffix))]) + suffix
+	}
+	used[strings.ToLower(title)] = true
+	return title
 }
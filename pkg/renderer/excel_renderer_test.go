@@ -50,3 +50,63 @@ func TestExcelRenderer_Render(t *testing.T) {
 		}
 	}
 }
+
+func TestExcelRenderer_RenderAll(t *testing.T) {
+	tables := []*parser.TableData{
+		{Name: "Prices", Headers: []string{"Item"}, Rows: []map[string]string{{"Item": "Tea"}}},
+		{Headers: []string{"Item"}, Rows: []map[string]string{{"Item": "Cake"}}},
+		{Name: "Prices", Headers: []string{"Item"}, Rows: []map[string]string{{"Item": "Jam"}}},
+	}
+
+	output, err := (&ExcelRenderer{}).RenderAll(tables)
+	if err != nil {
+		t.Fatalf("ExcelRenderer.RenderAll() error = %v", err)
+	}
+
+	f, err := excelize.OpenReader(bytes.NewReader([]byte(output)))
+	if err != nil {
+		t.Fatalf("Failed to read generated Excel: %v", err)
+	}
+	defer f.Close()
+
+	want := []string{"Prices", "Table 2", "Prices (2)"}
+	sheets := f.GetSheetList()
+	if len(sheets) != len(want) {
+		t.Fatalf("Sheets = %v, want %v", sheets, want)
+	}
+	for i, sheet := range want {
+		if sheets[i] != sheet {
+			t.Errorf("Sheet %d = %q, want %q", i, sheets[i], sheet)
+		}
+		value, _ := f.GetCellValue(sheet, "A2")
+		if value != tables[i].Rows[0]["Item"] {
+			t.Errorf("Sheet %q A2 = %q, want %q", sheet, value, tables[i].Rows[0]["Item"])
+		}
+	}
+}
+
+func TestExcelRenderer_Hyperlinks(t *testing.T) {
+	data := &parser.TableData{
+		Headers: []string{"Name"},
+		Rows:    []map[string]string{{"Name": "Ada"}},
+		Rich: []map[string]parser.RichText{
+			{"Name": {{Text: "Ada", Link: "https://example.com/ada"}}},
+		},
+	}
+
+	output, err := (&ExcelRenderer{}).Render(data)
+	if err != nil {
+		t.Fatalf("ExcelRenderer.Render() error = %v", err)
+	}
+
+	f, err := excelize.OpenReader(bytes.NewReader([]byte(output)))
+	if err != nil {
+		t.Fatalf("Failed to read generated Excel: %v", err)
+	}
+	defer f.Close()
+
+	ok, link, err := f.GetCellHyperLink("Sheet1", "A2")
+	if err != nil || !ok || link != "https://example.com/ada" {
+		t.Errorf("GetCellHyperLink(A2) = %v, %q, %v; want the cell link", ok, link, err)
+	}
+}
@@ -0,0 +1,109 @@
+package renderer
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+// FixedWidthRenderer implements Renderer for fixed-width text. Numeric
+// columns are right aligned unless the table sets an alignment; line breaks
+// inside cells become spaces.
+type FixedWidthRenderer struct {
+	// Widths fixes the width of each column in header order, padding or
+	// cutting values to fit. Columns past the end fit their longest value.
+	Widths []int
+	// Separator is written between columns
+	Separator string
+	// NoHeader leaves out the header line
+	NoHeader bool
+	// Underline writes a line of dashes under the header
+	Underline bool
+}
+
+func (r *FixedWidthRenderer) Render(data *parser.TableData) (string, error) {
+	cell := func(value string) string {
+		return strings.ReplaceAll(strings.ReplaceAll(value, "\r\n", " "), "\n", " ")
+	}
+
+	widths := make([]int, len(data.Headers))
+	for i, h := range data.Headers {
+		if i < len(r.Widths) && r.Widths[i] > 0 {
+			widths[i] = r.Widths[i]
+			continue
+		}
+		widths[i] = 1
+		if !r.NoHeader {
+			widths[i] = max(widths[i], utf8.RuneCountInString(h))
+		}
+		for _, rows := range [][]map[string]string{data.Rows, data.Footer} {
+			for _, row := range rows {
+				if width := utf8.RuneCountInString(cell(row[h])); width > widths[i] {
+					widths[i] = width
+				}
+			}
+		}
+	}
+
+	right := func(i int) bool {
+		h := data.Headers[i]
+		if align, ok := data.Align[h]; ok {
+			return align == parser.AlignRight
+		}
+		kind := data.ColumnType(h)
+		return kind == parser.TypeInteger || kind == parser.TypeFloat
+	}
+
+	var result strings.Builder
+	writeLine := func(values []string) {
+		var line strings.Builder
+		for i, value := range values {
+			if i > 0 {
+				line.WriteString(r.Separator)
+			}
+			line.WriteString(fitWidth(value, widths[i], right(i)))
+		}
+		// Padding is only significant when the widths are fixed
+		if len(r.Widths) > 0 {
+			result.WriteString(line.String() + "\n")
+		} else {
+			result.WriteString(strings.TrimRight(line.String(), " ") + "\n")
+		}
+	}
+
+	if !r.NoHeader {
+		writeLine(data.Headers)
+		if r.Underline {
+			dashes := make([]string, len(widths))
+			for i, width := range widths {
+				dashes[i] = strings.Repeat("-", width)
+			}
+			writeLine(dashes)
+		}
+	}
+	for _, rows := range [][]map[string]string{data.Rows, data.Footer} {
+		for _, row := range rows {
+			values := make([]string, len(data.Headers))
+			for i, h := range data.Headers {
+				values[i] = cell(row[h])
+			}
+			writeLine(values)
+		}
+	}
+
+	return result.String(), nil
+}
+
+// fitWidth pads or cuts a value to exactly width characters
+func fitWidth(value string, width int, right bool) string {
+	runes := []rune(value)
+	if len(runes) >= width {
+		return string(runes[:width])
+	}
+	padding := strings.Repeat(" ", width-len(runes))
+	if right {
+		return padding + value
+	}
+	return value + padding
+}
@@ -0,0 +1,62 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+func TestFixedWidthRenderer_Render(t *testing.T) {
+	data := &parser.TableData{
+		Headers: []string{"id", "name", "balance"},
+		Rows: []map[string]string{
+			{"id": "1", "name": "Ann\nLee", "balance": "10.5"},
+			{"id": "22", "name": "Bob", "balance": "3"},
+		},
+		Types: map[string]parser.ColumnType{"id": parser.TypeInteger, "balance": parser.TypeFloat},
+	}
+
+	tests := []struct {
+		name     string
+		renderer *FixedWidthRenderer
+		want     string
+	}{
+		{
+			name:     "Default",
+			renderer: &FixedWidthRenderer{Separator: " ", Underline: true},
+			want: "id name    balance\n" +
+				"-- ------- -------\n" +
+				" 1 Ann Lee    10.5\n" +
+				"22 Bob           3\n",
+		},
+		{
+			name:     "Fixed Widths Without Header",
+			renderer: &FixedWidthRenderer{Widths: []int{4, 5, 6}, NoHeader: true},
+			want:     "   1Ann L  10.5\n  22Bob       3\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.renderer.Render(data)
+			if err != nil {
+				t.Fatalf("FixedWidthRenderer.Render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("FixedWidthRenderer.Render() = %q, want %q", got, tt.want)
+			}
+
+			// Output with a header reads back into the same rows
+			if tt.renderer.NoHeader {
+				return
+			}
+			table, err := (&parser.FixedWidthParser{}).Parse([]byte(got))
+			if err != nil {
+				t.Fatalf("FixedWidthParser.Parse() error = %v", err)
+			}
+			if table.Rows[1]["name"] != "Bob" || table.Rows[0]["balance"] != "10.5" {
+				t.Errorf("FixedWidthParser.Parse() rows = %q", table.Rows)
+			}
+		})
+	}
+}
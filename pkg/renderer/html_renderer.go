@@ -66,6 +66,19 @@ func (r *HTMLRenderer) Render(data *parser.TableData) (string, error) {
 		html.WriteString("  </tr>\n")
 	}
 
+	// Add footer rows
+	if len(data.Footer) > 0 {
+		html.WriteString("  <tfoot>\n")
+		for _, row := range data.Footer {
+			html.WriteString("  <tr>\n")
+			for _, header := range data.Headers {
+				html.WriteString(fmt.Sprintf("    <td>%s</td>\n", row[header]))
+			}
+			html.WriteString("  </tr>\n")
+		}
+		html.WriteString("  </tfoot>\n")
+	}
+
 	// Close table
 	html.WriteString("</table>")
 
@@ -27,6 +27,7 @@ func NewImageRenderer() *ImageRenderer {
 }
 
 func (r *ImageRenderer) Render(data *parser.TableData) (string, error) {
+	data = withFooter(data)
 	// Calculate dimensions
 	widths := getColumnWidths(data)
 
@@ -0,0 +1,170 @@
+package renderer
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+// JiraRenderer implements Renderer for the wiki markup of Jira and
+// Confluence: ||header|| rows followed by |cell| rows. Footer rows are
+// written with header cells so totals stand out.
+type JiraRenderer struct{}
+
+func (r *JiraRenderer) Render(data *parser.TableData) (string, error) {
+	var result strings.Builder
+
+	header := make([]string, len(data.Headers))
+	for i, h := range data.Headers {
+		header[i] = jiraCell(jiraEscape(h))
+	}
+	result.WriteString("||" + strings.Join(header, "||") + "||\n")
+
+	for i, row := range data.Rows {
+		cells := make([]string, len(data.Headers))
+		for j, h := range data.Headers {
+			cell := jiraEscape(row[h])
+			if rt, ok := data.RichCell(i, h); ok {
+				cell = richJira(rt)
+			}
+			cells[j] = jiraCell(cell)
+		}
+		result.WriteString("|" + strings.Join(cells, "|") + "|\n")
+	}
+	for _, row := range data.Footer {
+		cells := make([]string, len(data.Headers))
+		for j, h := range data.Headers {
+			cells[j] = jiraCell(jiraEscape(row[h]))
+		}
+		result.WriteString("||" + strings.Join(cells, "||") + "||\n")
+	}
+
+	return result.String(), nil
+}
+
+// jiraCell writes an empty cell as a space, since || would start a header
+// cell
+func jiraCell(cell string) string {
+	if cell == "" {
+		return " "
+	}
+	return cell
+}
+
+// jiraReplacer escapes markup characters. Emoticons such as (y) and :) come
+// first, since they would otherwise be drawn as icons.
+var jiraReplacer = strings.NewReplacer(
+	"(y)", `\(y)`,
+	"(n)", `\(n)`,
+	"(i)", `\(i)`,
+	"(/)", `\(/)`,
+	"(x)", `\(x)`,
+	"(on)", `\(on)`,
+	"(off)", `\(off)`,
+	"(flag)", `\(flag)`,
+	"(flagoff)", `\(flagoff)`,
+	":)", `:\)`,
+	":(", `:\(`,
+	";)", `;\)`,
+	":P", `\:P`,
+	":D", `\:D`,
+	`\`, `&#92;`,
+	"|", `\|`,
+	"*", `\*`,
+	"_", `\_`,
+	"+", `\+`,
+	"-", `\-`,
+	"^", `\^`,
+	"~", `\~`,
+	"?", `\?`,
+	"!", `\!`,
+	"{", `\{`,
+	"}", `\}`,
+	"[", `\[`,
+	"]", `\]`,
+	"\r\n", `\\`,
+	"\n", `\\`,
+)
+
+// jiraEscape escapes the characters that start wiki markup. A backslash is
+// written as an entity, since \\ is a line break. Bare URLs are left as they
+// are so they are still linked.
+func jiraEscape(value string) string {
+	var result strings.Builder
+	for start := 0; start < len(value); {
+		end := strings.IndexAny(value[start:], " \t\r\n")
+		if end < 0 {
+			end = len(value)
+		} else {
+			end += start
+		}
+		word := value[start:end]
+		if isURL(word) {
+			result.WriteString(strings.ReplaceAll(word, "|", "%7C"))
+		} else {
+			result.WriteString(jiraReplacer.Replace(word))
+		}
+		if end < len(value) {
+			next := end + 1
+			if strings.HasPrefix(value[end:], "\r\n") {
+				next++
+			}
+			result.WriteString(jiraReplacer.Replace(value[end:next]))
+			end = next
+		}
+		start = end
+	}
+	return result.String()
+}
+
+// isURL reports whether a word is a link that wikis turn into one by itself
+func isURL(word string) bool {
+	for _, scheme := range []string{"http://", "https://", "mailto:"} {
+		if len(word) > len(scheme) && strings.HasPrefix(strings.ToLower(word), scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+// richJira converts formatted cell content to wiki markup. Links become
+// [text|url], emphasis uses * and _ and code uses {{ }}. Emphasis inside a
+// word is written {*}like{*} this, as bare markers only work next to spaces.
+func richJira(rt parser.RichText) string {
+	var result strings.Builder
+	for i, span := range rt {
+		bold, italic := "*", "_"
+		if span.Link == "" && (midWord(result.String(), span.Text) || i+1 < len(rt) && midWord(span.Text, rt[i+1].Text)) {
+			bold, italic = "{*}", "{_}"
+		}
+		text := wrapSpan(jiraEscape(span.Text), func(core string) string {
+			if span.Code {
+				return "{{" + core + "}}"
+			}
+			if span.Italic {
+				core = italic + core + italic
+			}
+			if span.Bold {
+				core = bold + core + bold
+			}
+			return core
+		})
+		if span.Link != "" {
+			text = "[" + text + "|" + strings.NewReplacer("|", "%7C", "]", "%5D", " ", "%20").Replace(span.Link) + "]"
+		}
+		result.WriteString(text)
+	}
+	return result.String()
+}
+
+// midWord reports whether two pieces of text meet without a space between
+func midWord(before, after string) bool {
+	if before == "" || after == "" {
+		return false
+	}
+	last, _ := utf8.DecodeLastRuneInString(before)
+	first, _ := utf8.DecodeRuneInString(after)
+	return !unicode.IsSpace(last) && !unicode.IsSpace(first)
+}
@@ -0,0 +1,42 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+func TestJiraRenderer_Render(t *testing.T) {
+	data := &parser.TableData{
+		Headers: []string{"key", "summary"},
+		Rows: []map[string]string{
+			{"key": "OPS-1", "summary": "a|b *not bold* {code} [x]"},
+			{"key": "OPS-2", "summary": "see https://example.com/a_b-c?q=1\nC:\\tmp"},
+			{"key": "OPS-3"},
+			{"key": "OPS-4", "summary": "ok (y) (n) (i) (x) (/) :) ;) :D"},
+			{"key": "OPS-5"},
+		},
+		Footer: []map[string]string{{"key": "Total", "summary": "3"}},
+		Rich: []map[string]parser.RichText{
+			nil, nil,
+			{"summary": {{Text: "docs", Link: "https://example.com/x y", Bold: true}, {Text: " and "}, {Text: "go vet", Code: true}}},
+			nil,
+			{"summary": {{Text: "foo"}, {Text: "bar", Bold: true}, {Text: "baz "}, {Text: "qux", Italic: true}, {Text: "."}}},
+		},
+	}
+
+	got, err := (&JiraRenderer{}).Render(data)
+	if err != nil {
+		t.Fatalf("JiraRenderer.Render() error = %v", err)
+	}
+	want := "||key||summary||\n" +
+		`|OPS\-1|a\|b \*not bold\* \{code\} \[x\]|` + "\n" +
+		`|OPS\-2|see https://example.com/a_b-c?q=1\\C:&#92;tmp|` + "\n" +
+		`|OPS\-3|[*docs*|https://example.com/x%20y] and {{go vet}}|` + "\n" +
+		`|OPS\-4|ok \(y) \(n) \(i) \(x) \(/) :\) ;\) \:D|` + "\n" +
+		`|OPS\-5|foo{*}bar{*}baz {_}qux{_}.|` + "\n" +
+		"||Total||3||\n"
+	if got != want {
+		t.Errorf("JiraRenderer.Render() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,296 @@
+package renderer
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+// JSONRenderer implements Renderer for JSON output. Each row becomes an
+// object with keys in header order; typed columns are written as JSON numbers
+// and booleans and missing cells as null.
+type JSONRenderer struct {
+	// HeaderRow writes a leading object mapping every header to itself, the
+	// layout older versions of gotable produced
+	HeaderRow bool
+	// Unflatten nests dotted headers such as address.city back into objects,
+	// and objects keyed 0..n-1 into arrays. Missing cells are left out.
+	Unflatten bool
+	// Separator splits headers when unflattening, "." by default
+	Separator string
+	// temporal writes the cells of date, time and timestamp columns as
+	// temporalLiteral values, for formats that have literals for them
+	temporal bool
+}
+
+// jsonObject is an output object that keeps its keys in insertion order
+type jsonObject struct {
+	keys   []string
+	values map[string]any
+}
+
+func newJSONObject() *jsonObject {
+	return &jsonObject{values: make(map[string]any)}
+}
+
+func (o *jsonObject) set(key string, value any) {
+	if _, ok := o.values[key]; !ok {
+		o.keys = append(o.keys, key)
+	}
+	o.values[key] = value
+}
+
+// jsonLiteral is an already encoded JSON value
+type jsonLiteral string
+
+// temporalLiteral is a date, time or timestamp cell in ISO 8601 form
+type temporalLiteral struct {
+	text string
+	kind parser.ColumnType
+}
+
+func (r *JSONRenderer) Render(data *parser.TableData) (string, error) {
+	data = withFooter(data)
+	var records []any
+
+	if r.HeaderRow {
+		header := newJSONObject()
+		for _, h := range data.Headers {
+			header.set(h, jsonLiteral(quoteJSON(h)))
+		}
+		records = append(records, header)
+	}
+
+	for _, row := range data.Rows {
+		records = append(records, r.record(data, row))
+	}
+
+	var result strings.Builder
+	writeJSON(&result, records, "")
+	return result.String(), nil
+}
+
+// record converts a row to the object written for it
+func (r *JSONRenderer) record(data *parser.TableData, row map[string]string) any {
+	if r.Unflatten {
+		return r.nestedObject(data, row)
+	}
+	return r.rowObject(data, row)
+}
+
+// rowObject converts a row to an ordered object of encoded cell values
+func (r *JSONRenderer) rowObject(data *parser.TableData, row map[string]string) *jsonObject {
+	obj := newJSONObject()
+	for _, h := range data.Headers {
+		obj.set(h, r.cell(data, row, h))
+	}
+	return obj
+}
+
+// cell encodes a cell of a row, as a temporalLiteral when the renderer
+// keeps dates and times and the value is a valid one
+func (r *JSONRenderer) cell(data *parser.TableData, row map[string]string, header string) any {
+	kind := data.ColumnType(header)
+	if value, ok := row[header]; ok && r.temporal && isTemporal(kind, value) {
+		return temporalLiteral{value, kind}
+	}
+	return cellLiteral(kind, row, header)
+}
+
+// isTemporal reports whether value is an ISO 8601 date, time or timestamp
+// of the given kind
+func isTemporal(kind parser.ColumnType, value string) bool {
+	switch kind {
+	case parser.TypeDate:
+		_, err := time.Parse(time.DateOnly, value)
+		return err == nil
+	case parser.TypeTime:
+		_, err := time.Parse("15:04:05.999999999", value)
+		return err == nil
+	case parser.TypeTimestamp:
+		_, _, ok := parseTimestamp(value)
+		return ok
+	}
+	return false
+}
+
+// nestedObject builds a row object from dotted headers
+func (r *JSONRenderer) nestedObject(data *parser.TableData, row map[string]string) any {
+	sep := r.Separator
+	if sep == "" {
+		sep = "."
+	}
+
+	obj := newJSONObject()
+	for _, h := range data.Headers {
+		if _, ok := row[h]; !ok {
+			continue
+		}
+		insertPath(obj, strings.Split(h, sep), sep, r.cell(data, row, h))
+	}
+	return arrayify(obj)
+}
+
+// insertPath stores value under a nested key path. When a prefix of the path
+// already holds a plain value the rest of the path is kept as a dotted key.
+func insertPath(obj *jsonObject, path []string, sep string, value any) {
+	if len(path) == 1 {
+		obj.set(path[0], value)
+		return
+	}
+
+	existing, ok := obj.values[path[0]]
+	child, isObject := existing.(*jsonObject)
+	if ok && !isObject {
+		obj.set(strings.Join(path, sep), value)
+		return
+	}
+	if !ok {
+		child = newJSONObject()
+		obj.set(path[0], child)
+	}
+	insertPath(child, path[1:], sep, value)
+}
+
+// arrayify turns objects whose keys are exactly 0..n-1 into arrays
+func arrayify(value any) any {
+	obj, ok := value.(*jsonObject)
+	if !ok {
+		return value
+	}
+	for _, key := range obj.keys {
+		obj.values[key] = arrayify(obj.values[key])
+	}
+
+	indexes := make([]int, 0, len(obj.keys))
+	for _, key := range obj.keys {
+		i, err := strconv.Atoi(key)
+		if err != nil || strconv.Itoa(i) != key {
+			return obj
+		}
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+	for i, index := range indexes {
+		if i != index {
+			return obj
+		}
+	}
+	if len(indexes) == 0 {
+		return obj
+	}
+
+	items := make([]any, len(indexes))
+	for _, key := range obj.keys {
+		i, _ := strconv.Atoi(key)
+		items[i] = obj.values[key]
+	}
+	return items
+}
+
+// cellLiteral encodes a cell according to its column type. Values that do not
+// fit the type are written as strings.
+func cellLiteral(kind parser.ColumnType, row map[string]string, header string) jsonLiteral {
+	value, ok := row[header]
+	if !ok {
+		return "null"
+	}
+
+	switch kind {
+	case parser.TypeInteger, parser.TypeFloat:
+		if isJSONNumber(value) {
+			return jsonLiteral(value)
+		}
+	case parser.TypeBoolean:
+		if value == "true" || value == "false" {
+			return jsonLiteral(value)
+		}
+	}
+	return jsonLiteral(quoteJSON(value))
+}
+
+func isJSONNumber(value string) bool {
+	if value == "" || !(value[0] == '-' || (value[0] >= '0' && value[0] <= '9')) {
+		return false
+	}
+	var n json.Number
+	return json.Unmarshal([]byte(value), &n) == nil
+}
+
+// writeJSON writes a value with two-space indentation, matching json.MarshalIndent
+func writeJSON(b *strings.Builder, value any, indent string) {
+	inner := indent + "  "
+	switch v := value.(type) {
+	case *jsonObject:
+		if len(v.keys) == 0 {
+			b.WriteString("{}")
+			return
+		}
+		b.WriteString("{\n")
+		for i, key := range v.keys {
+			b.WriteString(inner + quoteJSON(key) + ": ")
+			writeJSON(b, v.values[key], inner)
+			if i < len(v.keys)-1 {
+				b.WriteString(",")
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString(indent + "}")
+	case []any:
+		if len(v) == 0 {
+			b.WriteString("[]")
+			return
+		}
+		b.WriteString("[\n")
+		for i, item := range v {
+			b.WriteString(inner)
+			writeJSON(b, item, inner)
+			if i < len(v)-1 {
+				b.WriteString(",")
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString(indent + "]")
+	case jsonLiteral:
+		b.WriteString(string(v))
+	}
+}
+
+// writeCompactJSON writes a value without any whitespace
+func writeCompactJSON(b *strings.Builder, value any) {
+	switch v := value.(type) {
+	case *jsonObject:
+		b.WriteString("{")
+		for i, key := range v.keys {
+			if i > 0 {
+				b.WriteString(",")
+			}
+			b.WriteString(quoteJSON(key) + ":")
+			writeCompactJSON(b, v.values[key])
+		}
+		b.WriteString("}")
+	case []any:
+		b.WriteString("[")
+		for i, item := range v {
+			if i > 0 {
+				b.WriteString(",")
+			}
+			writeCompactJSON(b, item)
+		}
+		b.WriteString("]")
+	case jsonLiteral:
+		b.WriteString(string(v))
+	}
+}
+
+func quoteJSON(s string) string {
+	var b strings.Builder
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	enc.Encode(s)
+	return strings.TrimSuffix(b.String(), "\n")
+}
@@ -0,0 +1,121 @@
+package renderer
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+func TestJSONRenderer_Render(t *testing.T) {
+	data := &parser.TableData{
+		Headers: []string{"name", "age"},
+		Rows: []map[string]string{
+			{"name": "John", "age": "30"},
+			{"name": "Alice"},
+		},
+		Types: map[string]parser.ColumnType{"age": parser.TypeInteger},
+	}
+
+	tests := []struct {
+		name     string
+		renderer *JSONRenderer
+		want     string
+	}{
+		{
+			name:     "Records",
+			renderer: &JSONRenderer{},
+			want: `[
+  {
+    "name": "John",
+    "age": 30
+  },
+  {
+    "name": "Alice",
+    "age": null
+  }
+]`,
+		},
+		{
+			name:     "Legacy Header Row",
+			renderer: &JSONRenderer{HeaderRow: true},
+			want: `[
+  {
+    "name": "name",
+    "age": "age"
+  },
+  {
+    "name": "John",
+    "age": 30
+  },
+  {
+    "name": "Alice",
+    "age": null
+  }
+]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.renderer.Render(data)
+			if err != nil {
+				t.Fatalf("JSONRenderer.Render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("JSONRenderer.Render() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONRenderer_RoundTrip(t *testing.T) {
+	input := `[
+		{"name": "John", "age": 30, "score": 1.50, "active": true, "nick": null, "note": "<b>&</b>"},
+		{"name": "Alice", "age": 25, "score": 2, "active": false, "nick": "Al", "note": ""}
+	]`
+
+	data, err := (&parser.JSONParser{}).Parse([]byte(input))
+	if err != nil {
+		t.Fatalf("JSONParser.Parse() error = %v", err)
+	}
+	output, err := (&JSONRenderer{}).Render(data)
+	if err != nil {
+		t.Fatalf("JSONRenderer.Render() error = %v", err)
+	}
+
+	var want, got any
+	json.Unmarshal([]byte(input), &want)
+	if err := json.Unmarshal([]byte(output), &got); err != nil {
+		t.Fatalf("Rendered JSON is invalid: %v\n%s", err, output)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Round trip = %v, want %v", got, want)
+	}
+}
+
+func TestJSONRenderer_Unflatten(t *testing.T) {
+	input := `[
+		{"id": 1, "user": {"name": "Ann", "address": {"city": "Oslo"}}, "tags": ["a", "b"]},
+		{"id": 2, "user": {"name": "Bob"}, "tags": ["c"]}
+	]`
+
+	data, err := (&parser.JSONParser{}).Parse([]byte(input))
+	if err != nil {
+		t.Fatalf("JSONParser.Parse() error = %v", err)
+	}
+	output, err := (&JSONRenderer{Unflatten: true}).Render(data)
+	if err != nil {
+		t.Fatalf("JSONRenderer.Render() error = %v", err)
+	}
+
+	var want, got any
+	json.Unmarshal([]byte(input), &want)
+	if err := json.Unmarshal([]byte(output), &got); err != nil {
+		t.Fatalf("Rendered JSON is invalid: %v\n%s", err, output)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Round trip = %v, want %v", got, want)
+	}
+}
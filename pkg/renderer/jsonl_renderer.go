@@ -0,0 +1,27 @@
+package renderer
+
+import (
+	"strings"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+// JSONLRenderer implements Renderer for JSON Lines (NDJSON) output, writing
+// one compact JSON object per row
+type JSONLRenderer struct {
+	// Unflatten and Separator work as they do for JSONRenderer
+	Unflatten bool
+	Separator string
+}
+
+func (r *JSONLRenderer) Render(data *parser.TableData) (string, error) {
+	data = withFooter(data)
+	records := &JSONRenderer{Unflatten: r.Unflatten, Separator: r.Separator}
+
+	var result strings.Builder
+	for _, row := range data.Rows {
+		writeCompactJSON(&result, records.record(data, row))
+		result.WriteString("\n")
+	}
+	return result.String(), nil
+}
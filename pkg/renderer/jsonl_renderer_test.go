@@ -0,0 +1,47 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+func TestJSONLRenderer_Render(t *testing.T) {
+	data := &parser.TableData{
+		Headers: []string{"level", "ms", "user.name"},
+		Rows: []map[string]string{
+			{"level": "info", "ms": "12", "user.name": "ann"},
+			{"level": "warn", "ms": "250"},
+		},
+		Types: map[string]parser.ColumnType{"ms": parser.TypeInteger},
+	}
+
+	tests := []struct {
+		name     string
+		renderer *JSONLRenderer
+		want     string
+	}{
+		{
+			name:     "Flat",
+			renderer: &JSONLRenderer{},
+			want:     "{\"level\":\"info\",\"ms\":12,\"user.name\":\"ann\"}\n{\"level\":\"warn\",\"ms\":250,\"user.name\":null}\n",
+		},
+		{
+			name:     "Unflatten",
+			renderer: &JSONLRenderer{Unflatten: true},
+			want:     "{\"level\":\"info\",\"ms\":12,\"user\":{\"name\":\"ann\"}}\n{\"level\":\"warn\",\"ms\":250}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.renderer.Render(data)
+			if err != nil {
+				t.Fatalf("JSONLRenderer.Render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("JSONLRenderer.Render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package renderer
+
+import (
+	"strings"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+// LaTeXRenderer implements Renderer for LaTeX tables. Numeric columns are
+// right aligned unless the table sets an alignment, and special characters
+// in cells are escaped.
+type LaTeXRenderer struct {
+	// Booktabs draws the rules with \toprule, \midrule and \bottomrule from
+	// the booktabs package instead of \hline
+	Booktabs bool
+	// LongTable writes a longtable environment, which breaks across pages
+	// and repeats the header on each one
+	LongTable bool
+	// Caption and Label are set on the table. A tabular with either is
+	// wrapped in a table float.
+	Caption string
+	Label   string
+}
+
+func (r *LaTeXRenderer) Render(data *parser.TableData) (string, error) {
+	top, mid, bottom := `\hline`, `\hline`, `\hline`
+	if r.Booktabs {
+		top, mid, bottom = `\toprule`, `\midrule`, `\bottomrule`
+	}
+
+	spec := make([]string, len(data.Headers))
+	for i, h := range data.Headers {
+		spec[i] = latexAlign(data, h)
+	}
+
+	header := make([]string, len(data.Headers))
+	for i, h := range data.Headers {
+		header[i] = latexEscape(h)
+	}
+	headerLine := latexRow(header)
+
+	var result strings.Builder
+	caption := ""
+	if r.Caption != "" {
+		caption = `\caption{` + latexEscape(r.Caption) + `}`
+	}
+	label := ""
+	if r.Label != "" {
+		label = `\label{` + r.Label + `}`
+	}
+
+	env := "tabular"
+	if r.LongTable {
+		env = "longtable"
+	} else if caption != "" || label != "" {
+		result.WriteString(`\begin{table}[htbp]` + "\n" + `\centering` + "\n")
+		if caption != "" {
+			result.WriteString(caption + "\n")
+		}
+		if label != "" {
+			result.WriteString(label + "\n")
+		}
+	}
+
+	result.WriteString(`\begin{` + env + `}{` + strings.Join(spec, "") + "}\n")
+	if r.LongTable {
+		// The first page carries the caption; later pages repeat the header
+		if caption != "" || label != "" {
+			result.WriteString(caption + label + ` \\` + "\n")
+			result.WriteString(top + "\n" + headerLine + mid + "\n" + `\endfirsthead` + "\n")
+		}
+		result.WriteString(top + "\n" + headerLine + mid + "\n" + `\endhead` + "\n")
+		result.WriteString(bottom + "\n" + `\endfoot` + "\n")
+	} else {
+		result.WriteString(top + "\n" + headerLine + mid + "\n")
+	}
+
+	writeRows := func(rows []map[string]string, rich bool) {
+		for i, row := range rows {
+			cells := make([]string, len(data.Headers))
+			for j, h := range data.Headers {
+				if rt, ok := data.RichCell(i, h); ok && rich {
+					cells[j] = richLaTeX(rt)
+				} else {
+					cells[j] = latexEscape(row[h])
+				}
+			}
+			result.WriteString(latexRow(cells))
+		}
+	}
+	writeRows(data.Rows, true)
+	if len(data.Footer) > 0 {
+		result.WriteString(mid + "\n")
+		writeRows(data.Footer, false)
+	}
+
+	if !r.LongTable {
+		result.WriteString(bottom + "\n")
+	}
+	result.WriteString(`\end{` + env + "}\n")
+	if !r.LongTable && (caption != "" || label != "") {
+		result.WriteString(`\end{table}` + "\n")
+	}
+
+	return result.String(), nil
+}
+
+// latexRow joins the cells of a row and ends it with \\. A first cell
+// starting with [ is braced, or the \\ or rule before it would read it as
+// an optional argument.
+func latexRow(cells []string) string {
+	if len(cells) > 0 && strings.HasPrefix(cells[0], "[") {
+		cells[0] = "{[}" + cells[0][1:]
+	}
+	return strings.Join(cells, " & ") + ` \\` + "\n"
+}
+
+// latexAlign returns the column specifier for a column
+func latexAlign(data *parser.TableData, header string) string {
+	switch columnAlign(data, header, true) {
+	case parser.AlignCenter:
+		return "c"
+	case parser.AlignRight:
+		return "r"
+	}
+	return "l"
+}
+
+var latexReplacer = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	`&`, `\&`,
+	`%`, `\%`,
+	`$`, `\$`,
+	`#`, `\#`,
+	`_`, `\_`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`~`, `\textasciitilde{}`,
+	`^`, `\textasciicircum{}`,
+	"\r\n", " ",
+	"\n", " ",
+)
+
+// latexEscape escapes the characters LaTeX treats specially. Line breaks
+// become spaces, since a tabular cell holds a single line.
+func latexEscape(value string) string {
+	return latexReplacer.Replace(value)
+}
+
+// richLaTeX converts formatted cell content to LaTeX. Links use \href from
+// the hyperref package.
+func richLaTeX(rt parser.RichText) string {
+	var result strings.Builder
+	for _, span := range rt {
+		text := latexEscape(span.Text)
+		if span.Code {
+			text = `\texttt{` + text + `}`
+		} else {
+			if span.Italic {
+				text = `\textit{` + text + `}`
+			}
+			if span.Bold {
+				text = `\textbf{` + text + `}`
+			}
+		}
+		if span.Link != "" {
+			link := strings.NewReplacer(`\`, `\\`, `#`, `\#`, `%`, `\%`, `{`, `\{`, `}`, `\}`).Replace(span.Link)
+			text = `\href{` + link + `}{` + text + `}`
+		}
+		result.WriteString(text)
+	}
+	return result.String()
+}
@@ -0,0 +1,79 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+func TestLaTeXRenderer_Render(t *testing.T) {
+	data := &parser.TableData{
+		Headers: []string{"item", "cost_$", "note"},
+		Rows: []map[string]string{
+			{"item": "R&D", "cost_$": "12.5", "note": "50% {est.}"},
+			{"item": `C:\tmp`, "cost_$": "3", "note": "~x^2 #1"},
+			{"item": "[draft] paper", "cost_$": "0", "note": "[a]"},
+		},
+		Footer: []map[string]string{{"item": "Total", "cost_$": "15.5"}},
+		Types:  map[string]parser.ColumnType{"cost_$": parser.TypeFloat},
+		Align:  map[string]parser.Alignment{"note": parser.AlignCenter},
+	}
+	body := `R\&D & 12.5 & 50\% \{est.\} \\` + "\n" +
+		`C:\textbackslash{}tmp & 3 & \textasciitilde{}x\textasciicircum{}2 \#1 \\` + "\n" +
+		`{[}draft] paper & 0 & [a] \\` + "\n"
+	header := `item & cost\_\$ & note \\` + "\n"
+
+	tests := []struct {
+		name     string
+		renderer *LaTeXRenderer
+		want     string
+	}{
+		{
+			name:     "Tabular",
+			renderer: &LaTeXRenderer{},
+			want: `\begin{tabular}{lrc}` + "\n" + `\hline` + "\n" + header + `\hline` + "\n" + body +
+				`\hline` + "\n" + `Total & 15.5 &  \\` + "\n" + `\hline` + "\n" + `\end{tabular}` + "\n",
+		},
+		{
+			name:     "Booktabs Float",
+			renderer: &LaTeXRenderer{Booktabs: true, Caption: "Costs & fees", Label: "tab:costs"},
+			want: `\begin{table}[htbp]` + "\n" + `\centering` + "\n" + `\caption{Costs \& fees}` + "\n" + `\label{tab:costs}` + "\n" +
+				`\begin{tabular}{lrc}` + "\n" + `\toprule` + "\n" + header + `\midrule` + "\n" + body +
+				`\midrule` + "\n" + `Total & 15.5 &  \\` + "\n" + `\bottomrule` + "\n" + `\end{tabular}` + "\n" + `\end{table}` + "\n",
+		},
+		{
+			name:     "Longtable",
+			renderer: &LaTeXRenderer{Booktabs: true, LongTable: true, Caption: "Costs"},
+			want: `\begin{longtable}{lrc}` + "\n" + `\caption{Costs} \\` + "\n" +
+				`\toprule` + "\n" + header + `\midrule` + "\n" + `\endfirsthead` + "\n" +
+				`\toprule` + "\n" + header + `\midrule` + "\n" + `\endhead` + "\n" +
+				`\bottomrule` + "\n" + `\endfoot` + "\n" + body +
+				`\midrule` + "\n" + `Total & 15.5 &  \\` + "\n" + `\end{longtable}` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.renderer.Render(data)
+			if err != nil {
+				t.Fatalf("LaTeXRenderer.Render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("LaTeXRenderer.Render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRichLaTeX(t *testing.T) {
+	rt := parser.RichText{
+		{Text: "see "},
+		{Text: "the_docs", Link: "https://example.com/a#b%20c", Bold: true},
+		{Text: " or ", Italic: true},
+		{Text: "x & y", Code: true},
+	}
+	want := `see \href{https://example.com/a\#b\%20c}{\textbf{the\_docs}}\textit{ or }\texttt{x \& y}`
+	if got := richLaTeX(rt); got != want {
+		t.Errorf("richLaTeX() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,137 @@
+package renderer
+
+import (
+	"strings"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+	"github.com/mattn/go-runewidth"
+)
+
+// textLayout lays a table out as monospaced text for the ASCII and plain
+// text markup formats. Cells are split into lines and every column is as
+// wide, in terminal cells, as its widest line.
+type textLayout struct {
+	header [][]string
+	rows   [][][]string
+	footer [][][]string
+	widths []int
+	align  []parser.Alignment
+	// pad is the number of spaces on each side of a cell
+	pad int
+}
+
+// newTextLayout lays out a table, passing each header and cell through text
+// first when it is set. Columns are aligned as the table says, otherwise
+// numeric columns are right aligned if numeric is set.
+func newTextLayout(data *parser.TableData, text func(string) string, numeric bool) *textLayout {
+	l := &textLayout{
+		widths: make([]int, len(data.Headers)),
+		align:  make([]parser.Alignment, len(data.Headers)),
+		pad:    1,
+	}
+
+	cells := func(values []string) [][]string {
+		lines := make([][]string, len(values))
+		for i, value := range values {
+			if text != nil {
+				value = text(value)
+			}
+			lines[i] = cellLines(value)
+			for _, line := range lines[i] {
+				l.widths[i] = max(l.widths[i], textWidth(line))
+			}
+		}
+		return lines
+	}
+	rows := func(rows []map[string]string) [][][]string {
+		result := make([][][]string, len(rows))
+		for n, row := range rows {
+			values := make([]string, len(data.Headers))
+			for i, h := range data.Headers {
+				values[i] = row[h]
+			}
+			result[n] = cells(values)
+		}
+		return result
+	}
+
+	l.header = cells(data.Headers)
+	l.rows = rows(data.Rows)
+	l.footer = rows(data.Footer)
+
+	for i, h := range data.Headers {
+		l.widths[i] = max(l.widths[i], 1)
+		l.align[i] = columnAlign(data, h, numeric)
+	}
+	return l
+}
+
+// columnAlign returns the alignment the table gives a column, otherwise
+// right for numeric columns if numeric is set and left for the rest
+func columnAlign(data *parser.TableData, header string, numeric bool) parser.Alignment {
+	if align, ok := data.Align[header]; ok {
+		return align
+	}
+	if kind := data.ColumnType(header); numeric && (kind == parser.TypeInteger || kind == parser.TypeFloat) {
+		return parser.AlignRight
+	}
+	return parser.AlignLeft
+}
+
+// rule draws a horizontal line across the columns
+func (l *textLayout) rule(left, fill, join, right string) string {
+	parts := make([]string, len(l.widths))
+	for i, width := range l.widths {
+		parts[i] = strings.Repeat(fill, width+2*l.pad)
+	}
+	return left + strings.Join(parts, join) + right
+}
+
+// lines draws a row as text, one line for each line of its tallest cell
+func (l *textLayout) lines(cells [][]string, left, join, right string) []string {
+	height := 1
+	for _, cell := range cells {
+		height = max(height, len(cell))
+	}
+
+	padding := strings.Repeat(" ", l.pad)
+	result := make([]string, height)
+	for n := range result {
+		parts := make([]string, len(cells))
+		for i, cell := range cells {
+			line := ""
+			if n < len(cell) {
+				line = cell[n]
+			}
+			parts[i] = padding + padText(line, l.widths[i], l.align[i]) + padding
+		}
+		result[n] = left + strings.Join(parts, join) + right
+	}
+	return result
+}
+
+// textWidth is the number of terminal cells text takes up, counting East
+// Asian wide characters and emoji as two
+func textWidth(text string) int {
+	return runewidth.StringWidth(text)
+}
+
+// padText pads text with spaces to width terminal cells
+func padText(text string, width int, align parser.Alignment) string {
+	fill := width - textWidth(text)
+	if fill <= 0 {
+		return text
+	}
+	switch align {
+	case parser.AlignRight:
+		return strings.Repeat(" ", fill) + text
+	case parser.AlignCenter:
+		return strings.Repeat(" ", fill/2) + text + strings.Repeat(" ", fill-fill/2)
+	}
+	return text + strings.Repeat(" ", fill)
+}
+
+// cellLines splits a cell into its lines
+func cellLines(value string) []string {
+	return strings.Split(strings.ReplaceAll(value, "\r\n", "\n"), "\n")
+}
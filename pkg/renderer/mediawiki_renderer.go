@@ -0,0 +1,118 @@
+package renderer
+
+import (
+	"strings"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+// MediaWikiRenderer implements Renderer for MediaWiki wikitables. Markup
+// characters in cells are written as entities, line breaks as <br /> and
+// footer rows are kept at the bottom when the table is sorted.
+type MediaWikiRenderer struct {
+	// Caption is written above the table
+	Caption string
+}
+
+func (r *MediaWikiRenderer) Render(data *parser.TableData) (string, error) {
+	var result strings.Builder
+	result.WriteString(`{| class="wikitable"` + "\n")
+	if r.Caption != "" {
+		result.WriteString("|+ " + mediawikiEscape(r.Caption) + "\n")
+	}
+
+	style := make([]string, len(data.Headers))
+	for i, h := range data.Headers {
+		switch columnAlign(data, h, true) {
+		case parser.AlignCenter:
+			style[i] = `style="text-align:center" | `
+		case parser.AlignRight:
+			style[i] = `style="text-align:right" | `
+		}
+	}
+
+	header := make([]string, len(data.Headers))
+	for i, h := range data.Headers {
+		header[i] = mediawikiEscape(h)
+	}
+	result.WriteString("|-\n! " + strings.Join(header, " !! ") + "\n")
+
+	writeRow := func(cells []string) {
+		for i := range cells {
+			cells[i] = style[i] + cells[i]
+		}
+		result.WriteString("| " + strings.Join(cells, " || ") + "\n")
+	}
+	for i, row := range data.Rows {
+		cells := make([]string, len(data.Headers))
+		for j, h := range data.Headers {
+			cells[j] = mediawikiEscape(row[h])
+			if rt, ok := data.RichCell(i, h); ok {
+				cells[j] = richMediaWiki(rt)
+			}
+		}
+		result.WriteString("|-\n")
+		writeRow(cells)
+	}
+	for _, row := range data.Footer {
+		cells := make([]string, len(data.Headers))
+		for j, h := range data.Headers {
+			cells[j] = mediawikiEscape(row[h])
+		}
+		result.WriteString(`|- class="sortbottom"` + "\n")
+		writeRow(cells)
+	}
+	result.WriteString("|}\n")
+
+	return result.String(), nil
+}
+
+var mediawikiReplacer = strings.NewReplacer(
+	"&", "&amp;",
+	"<", "&lt;",
+	">", "&gt;",
+	"|", "&#124;",
+	"!!", "&#33;&#33;",
+	"''", "&#39;&#39;",
+	"[", "&#91;",
+	"]", "&#93;",
+	"{", "&#123;",
+	"}", "&#125;",
+	"~~~", "&#126;&#126;&#126;",
+	"__", "&#95;&#95;",
+	"\r\n", "<br />",
+	"\n", "<br />",
+)
+
+// mediawikiEscape writes the characters that start wikitext markup, or
+// separate table cells, as entities
+func mediawikiEscape(value string) string {
+	return mediawikiReplacer.Replace(value)
+}
+
+// richMediaWiki converts formatted cell content to wikitext. Links become
+// external links, bold and italic use runs of apostrophes and code uses
+// <code>.
+func richMediaWiki(rt parser.RichText) string {
+	var result strings.Builder
+	for _, span := range rt {
+		text := wrapSpan(mediawikiEscape(span.Text), func(core string) string {
+			if span.Code {
+				return "<code>" + core + "</code>"
+			}
+			if span.Italic {
+				core = "''" + core + "''"
+			}
+			if span.Bold {
+				core = "'''" + core + "'''"
+			}
+			return core
+		})
+		if span.Link != "" {
+			link := strings.NewReplacer(" ", "%20", "]", "%5D", "|", "%7C", "<", "%3C", ">", "%3E").Replace(span.Link)
+			text = "[" + link + " " + text + "]"
+		}
+		result.WriteString(text)
+	}
+	return result.String()
+}
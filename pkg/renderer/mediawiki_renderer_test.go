@@ -0,0 +1,42 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+func TestMediaWikiRenderer_Render(t *testing.T) {
+	data := &parser.TableData{
+		Headers: []string{"a!!b", "qty"},
+		Rows: []map[string]string{
+			{"a!!b": "x|y [[Page]] {{tpl}} ''it'' <br>", "qty": "5"},
+			{"a!!b": "two\nlines"},
+		},
+		Footer: []map[string]string{{"a!!b": "Total", "qty": "5"}},
+		Align:  map[string]parser.Alignment{"qty": parser.AlignRight},
+		Rich: []map[string]parser.RichText{
+			nil,
+			{"a!!b": {{Text: "bold ", Bold: true}, {Text: "x", Link: "https://example.com/a b"}}},
+		},
+	}
+
+	got, err := (&MediaWikiRenderer{Caption: "Stock"}).Render(data)
+	if err != nil {
+		t.Fatalf("MediaWikiRenderer.Render() error = %v", err)
+	}
+	want := "{| class=\"wikitable\"\n" +
+		"|+ Stock\n" +
+		"|-\n" +
+		"! a&#33;&#33;b !! qty\n" +
+		"|-\n" +
+		"| x&#124;y &#91;&#91;Page&#93;&#93; &#123;&#123;tpl&#125;&#125; &#39;&#39;it&#39;&#39; &lt;br&gt; || style=\"text-align:right\" | 5\n" +
+		"|-\n" +
+		"| '''bold''' [https://example.com/a%20b x] || style=\"text-align:right\" | \n" +
+		"|- class=\"sortbottom\"\n" +
+		"| Total || style=\"text-align:right\" | 5\n" +
+		"|}\n"
+	if got != want {
+		t.Errorf("MediaWikiRenderer.Render() = %q, want %q", got, want)
+	}
+}
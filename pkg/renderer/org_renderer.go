@@ -0,0 +1,57 @@
+package renderer
+
+import (
+	"strings"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+// OrgRenderer implements Renderer for Org mode tables, laid out the way
+// org-table-align leaves them with numeric columns right aligned. Org
+// tables have no line breaks inside cells, so they become spaces.
+type OrgRenderer struct {
+	// Caption and Label are written as the #+CAPTION and #+NAME of the table
+	Caption string
+	Label   string
+}
+
+func (r *OrgRenderer) Render(data *parser.TableData) (string, error) {
+	layout := newTextLayout(data, orgText, true)
+
+	var result strings.Builder
+	if r.Caption != "" {
+		result.WriteString("#+CAPTION: " + strings.ReplaceAll(r.Caption, "\n", " ") + "\n")
+	}
+	if r.Label != "" {
+		result.WriteString("#+NAME: " + r.Label + "\n")
+	}
+
+	rule := layout.rule("|", "-", "+", "|") + "\n"
+	writeRow := func(cells [][]string) {
+		result.WriteString(layout.lines(cells, "|", "|", "|")[0] + "\n")
+	}
+
+	writeRow(layout.header)
+	result.WriteString(rule)
+	for _, cells := range layout.rows {
+		writeRow(cells)
+	}
+	if len(layout.footer) > 0 {
+		result.WriteString(rule)
+		for _, cells := range layout.footer {
+			writeRow(cells)
+		}
+	}
+
+	return result.String(), nil
+}
+
+// orgText puts a cell on one line and escapes the column separator
+func orgText(value string) string {
+	lines := cellLines(value)
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	value = strings.TrimSpace(strings.Join(lines, " "))
+	return strings.ReplaceAll(value, "|", `\vert{}`)
+}
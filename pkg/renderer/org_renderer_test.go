@@ -0,0 +1,47 @@
+package renderer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+func TestOrgRenderer_Render(t *testing.T) {
+	data := &parser.TableData{
+		Headers: []string{"name", "score"},
+		Rows: []map[string]string{
+			{"name": "Ann\nLee", "score": "9.5"},
+			{"name": "a|b", "score": "10"},
+		},
+		Footer: []map[string]string{{"name": "Mean", "score": "9.75"}},
+		Types:  map[string]parser.ColumnType{"score": parser.TypeFloat},
+	}
+
+	got, err := (&OrgRenderer{Caption: "Scores", Label: "tbl-scores"}).Render(data)
+	if err != nil {
+		t.Fatalf("OrgRenderer.Render() error = %v", err)
+	}
+	want := "#+CAPTION: Scores\n" +
+		"#+NAME: tbl-scores\n" +
+		"| name      | score |\n" +
+		"|-----------+-------|\n" +
+		"| Ann Lee   |   9.5 |\n" +
+		"| a\\vert{}b |    10 |\n" +
+		"|-----------+-------|\n" +
+		"| Mean      |  9.75 |\n"
+	if got != want {
+		t.Errorf("OrgRenderer.Render() = %q, want %q", got, want)
+	}
+
+	// Org tables read back as text tables; Org has no footer, so the footer
+	// comes back as a row
+	table, err := (&parser.ASCIIParser{}).Parse([]byte(got))
+	if err != nil {
+		t.Fatalf("ASCIIParser.Parse() error = %v", err)
+	}
+	wantRows := []map[string]string{{"name": "Ann Lee", "score": "9.5"}, {"name": `a\vert{}b`, "score": "10"}, {"name": "Mean", "score": "9.75"}}
+	if !reflect.DeepEqual(table.Rows, wantRows) {
+		t.Errorf("ASCIIParser.Parse() rows = %v, want %v", table.Rows, wantRows)
+	}
+}
@@ -0,0 +1,81 @@
+package renderer
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/compress"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+// ParquetRenderer implements Renderer for Apache Parquet files. Column types
+// come from the data as for SQLiteRenderer and every column is nullable.
+type ParquetRenderer struct {
+	// Compression names the codec: snappy (default), gzip, zstd, brotli,
+	// lz4 or none
+	Compression string
+	// RowGroupSize is the most rows per row group, 65536 by default
+	RowGroupSize int
+}
+
+// ParseCompression looks up a Parquet compression codec by name
+func ParseCompression(name string) (compress.Compression, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "snappy":
+		return compress.Codecs.Snappy, nil
+	case "none", "uncompressed":
+		return compress.Codecs.Uncompressed, nil
+	case "gzip":
+		return compress.Codecs.Gzip, nil
+	case "zstd":
+		return compress.Codecs.Zstd, nil
+	case "brotli":
+		return compress.Codecs.Brotli, nil
+	case "lz4", "lz4_raw":
+		return compress.Codecs.Lz4Raw, nil
+	}
+	return 0, fmt.Errorf("unknown compression %q: use snappy, gzip, zstd, brotli, lz4 or none", name)
+}
+
+func (r *ParquetRenderer) Render(data *parser.TableData) (string, error) {
+	data = withFooter(data)
+	codec, err := ParseCompression(r.Compression)
+	if err != nil {
+		return "", err
+	}
+	if len(data.Headers) == 0 {
+		return "", fmt.Errorf("no columns to write")
+	}
+	groupSize := r.RowGroupSize
+	if groupSize <= 0 {
+		groupSize = 65536
+	}
+
+	schema, kinds := arrowSchema(data)
+	props := parquet.NewWriterProperties(
+		parquet.WithCompression(codec),
+		parquet.WithMaxRowGroupLength(int64(groupSize)),
+	)
+
+	var buf bytes.Buffer
+	writer, err := pqarrow.NewFileWriter(schema, &buf, props, pqarrow.DefaultWriterProps())
+	if err != nil {
+		return "", err
+	}
+	for start := 0; start < len(data.Rows); start += groupSize {
+		record := arrowRecord(schema, kinds, data.Headers, data.Rows[start:min(start+groupSize, len(data.Rows))])
+		err := writer.Write(record)
+		record.Release()
+		if err != nil {
+			writer.Close()
+			return "", err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,59 @@
+package renderer
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+func TestParquetRenderer_Render(t *testing.T) {
+	data := &parser.TableData{
+		Headers: []string{"id", "name", "score", "code"},
+		Rows: []map[string]string{
+			{"id": "1", "name": "John", "score": "9.5", "code": "7"},
+			{"id": "2", "name": "Zoë", "score": "", "code": "x"},
+			{"id": "3"},
+		},
+		Types: map[string]parser.ColumnType{"code": parser.TypeInteger},
+	}
+	want := []map[string]string{
+		{"id": "1", "name": "John", "score": "9.5", "code": "7"},
+		{"id": "2", "name": "Zoë", "code": "x"},
+		{"id": "3"},
+	}
+	// code holds a value that is not an integer, so it is stored as text
+	wantTypes := map[string]parser.ColumnType{"id": parser.TypeInteger, "score": parser.TypeFloat}
+
+	for _, codec := range []string{"", "gzip", "zstd", "brotli", "lz4", "none"} {
+		t.Run("Compression "+codec, func(t *testing.T) {
+			output, err := (&ParquetRenderer{Compression: codec, RowGroupSize: 2}).Render(data)
+			if err != nil {
+				t.Fatalf("ParquetRenderer.Render() error = %v", err)
+			}
+
+			pf, err := file.NewParquetReader(bytes.NewReader([]byte(output)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if groups := pf.NumRowGroups(); groups != 2 {
+				t.Errorf("row groups = %d, want 2", groups)
+			}
+			pf.Close()
+
+			got, err := (&parser.ParquetParser{}).Parse([]byte(output))
+			if err != nil {
+				t.Fatalf("ParquetParser.Parse() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.Rows, want) || !reflect.DeepEqual(got.Types, wantTypes) {
+				t.Errorf("round trip = %v %v, want %v %v", got.Rows, got.Types, want, wantTypes)
+			}
+		})
+	}
+
+	if _, err := (&ParquetRenderer{Compression: "lzo"}).Render(data); err == nil {
+		t.Errorf("ParquetRenderer.Render() with an unknown codec should fail")
+	}
+}
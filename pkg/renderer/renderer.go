@@ -1,9 +1,8 @@
 package renderer
 
 import (
-	"encoding/csv"
-	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/gowtham2003/gotable/pkg/parser"
@@ -14,6 +13,12 @@ type Renderer interface {
 	Render(data *parser.TableData) (string, error)
 }
 
+// MultiRenderer is implemented by renderers that can put several tables
+// into a single output
+type MultiRenderer interface {
+	RenderAll(tables []*parser.TableData) (string, error)
+}
+
 // Styleable interface for renderers that support styling
 type Styleable interface {
 	SetStyle(StyleOptions)
@@ -31,14 +36,15 @@ type StyleOptions struct {
 // ASCIIRenderer implements Renderer for ASCII table output
 type ASCIIRenderer struct{}
 
-// CSVRenderer implements Renderer for CSV output
-type CSVRenderer struct{}
-
-// JSONRenderer implements Renderer for JSON output
-type JSONRenderer struct{}
-
-// MarkdownRenderer implements Renderer for Markdown table output
-type MarkdownRenderer struct{}
+// MarkdownRenderer implements Renderer for GitHub Flavored Markdown tables.
+// Pipes and backslashes in cells are escaped, line breaks become <br> and
+// the delimiter row carries the alignment the table sets.
+type MarkdownRenderer struct {
+	// Pad pads every column to its widest cell so the source lines up
+	Pad bool
+	// Compact leaves out the spaces around cells and the padding
+	Compact bool
+}
 
 func NewRenderer(format string) (Renderer, error) {
 	switch strings.ToLower(format) {
@@ -46,8 +52,12 @@ func NewRenderer(format string) (Renderer, error) {
 		return &ASCIIRenderer{}, nil
 	case "csv":
 		return &CSVRenderer{}, nil
+	case "tsv":
+		return &CSVRenderer{Delimiter: '\t'}, nil
 	case "json":
 		return &JSONRenderer{}, nil
+	case "jsonl", "ndjson":
+		return &JSONLRenderer{}, nil
 	case "markdown":
 		return &MarkdownRenderer{}, nil
 	case "png":
@@ -56,6 +66,40 @@ func NewRenderer(format string) (Renderer, error) {
 		return NewHTMLRenderer(), nil
 	case "xlsx":
 		return &ExcelRenderer{}, nil
+	case "xml":
+		return &XMLRenderer{}, nil
+	case "fixed", "fwf":
+		return &FixedWidthRenderer{Separator: " ", Underline: true}, nil
+	case "yaml", "yml":
+		return &YAMLRenderer{}, nil
+	case "toml":
+		return &TOMLRenderer{}, nil
+	case "sqlite", "sqlite3", "db":
+		return &SQLiteRenderer{}, nil
+	case "sql":
+		return &SQLRenderer{CreateTable: true}, nil
+	case "parquet":
+		return &ParquetRenderer{}, nil
+	case "arrow", "feather", "ipc":
+		return &ArrowRenderer{}, nil
+	case "arrows":
+		return &ArrowRenderer{Stream: true}, nil
+	case "latex", "tex":
+		return &LaTeXRenderer{}, nil
+	case "rst", "restructuredtext":
+		return &RSTRenderer{}, nil
+	case "asciidoc", "adoc":
+		return &AsciiDocRenderer{}, nil
+	case "org":
+		return &OrgRenderer{}, nil
+	case "jira":
+		return &JiraRenderer{}, nil
+	case "confluence":
+		return &ConfluenceRenderer{}, nil
+	case "mediawiki", "wikitext":
+		return &MediaWikiRenderer{}, nil
+	case "template":
+		return &TemplateRenderer{}, nil
 	default:
 		return nil, fmt.Errorf("unsupported output format: %s", format)
 	}
@@ -63,105 +107,166 @@ func NewRenderer(format string) (Renderer, error) {
 
 func (r *ASCIIRenderer) Render(data *parser.TableData) (string, error) {
 	var result strings.Builder
-	widths := getColumnWidths(data)
+	layout := newTextLayout(data, nil, false)
+	separator := layout.rule("+", "-", "+", "+") + "\n"
+	heavy := layout.rule("+", "=", "+", "+") + "\n"
+	writeRow := func(cells [][]string) {
+		for _, line := range layout.lines(cells, "|", "|", "|") {
+			result.WriteString(line + "\n")
+		}
+	}
 
-	// Create separator line
-	separator := createSeparator(data.Headers, widths)
-	result.WriteString(separator)
+	// Rows of several lines are drawn as a grid, with a rule under every
+	// row and a rule of = under the header, so ASCIIParser can tell where
+	// each row ends
+	grid := false
+	for _, cells := range append(append([][][]string{}, layout.rows...), layout.footer...) {
+		for _, cell := range cells {
+			grid = grid || len(cell) > 1
+		}
+	}
+	writeRows := func(rows [][][]string) {
+		for i, cells := range rows {
+			if grid && i > 0 {
+				result.WriteString(separator)
+			}
+			writeRow(cells)
+		}
+	}
 
 	// Write headers
-	result.WriteString("|")
-	for _, h := range data.Headers {
-		format := fmt.Sprintf(" %%-%ds |", widths[h])
-		result.WriteString(fmt.Sprintf(format, h))
-	}
-	result.WriteString("\n")
 	result.WriteString(separator)
+	writeRow(layout.header)
+	if grid {
+		result.WriteString(heavy)
+	} else {
+		result.WriteString(separator)
+	}
 
 	// Write data rows
-	for _, row := range data.Rows {
-		result.WriteString("|")
-		for _, h := range data.Headers {
-			format := fmt.Sprintf(" %%-%ds |", widths[h])
-			result.WriteString(fmt.Sprintf(format, row[h]))
-		}
-		result.WriteString("\n")
+	writeRows(layout.rows)
+
+	// Write footer rows below a rule of =, which ASCIIParser tells apart
+	// from the other separators
+	if len(layout.footer) > 0 {
+		result.WriteString(heavy)
+		writeRows(layout.footer)
 	}
 	result.WriteString(separator)
 
 	return result.String(), nil
 }
 
-func (r *CSVRenderer) Render(data *parser.TableData) (string, error) {
-	var result strings.Builder
-	writer := csv.NewWriter(&result)
-
-	// Write headers
-	if err := writer.Write(data.Headers); err != nil {
-		return "", err
+func (r *MarkdownRenderer) Render(data *parser.TableData) (string, error) {
+	data = withFooter(data)
+	if len(data.Headers) == 0 {
+		return "", fmt.Errorf("no columns to write")
 	}
 
-	// Write rows
-	for _, row := range data.Rows {
-		record := make([]string, len(data.Headers))
+	header := make([]string, len(data.Headers))
+	for i, h := range data.Headers {
+		header[i] = markdownEscape(h)
+	}
+	rows := make([][]string, len(data.Rows))
+	for n, row := range data.Rows {
+		rows[n] = make([]string, len(data.Headers))
 		for i, h := range data.Headers {
-			record[i] = row[h]
-		}
-		if err := writer.Write(record); err != nil {
-			return "", err
+			rows[n][i] = markdownEscape(row[h])
+			if rt, ok := data.RichCell(n, h); ok {
+				rows[n][i] = richMarkdown(rt)
+			}
 		}
 	}
 
-	writer.Flush()
-	return result.String(), writer.Error()
-}
-
-func (r *JSONRenderer) Render(data *parser.TableData) (string, error) {
-	output := make([]map[string]string, len(data.Rows)+1)
+	pad := r.Pad && !r.Compact
+	widths := make([]int, len(data.Headers))
+	for i := range widths {
+		widths[i] = 3
+		if !pad {
+			continue
+		}
+		widths[i] = max(widths[i], textWidth(header[i]))
+		for _, cells := range rows {
+			widths[i] = max(widths[i], textWidth(cells[i]))
+		}
+	}
 
-	// Add headers as first row
-	headerRow := make(map[string]string)
-	for _, h := range data.Headers {
-		headerRow[h] = h
+	var result strings.Builder
+	writeRow := func(cells []string) {
+		for i, cell := range cells {
+			switch {
+			case r.Compact:
+				// A backslash before the pipe would escape it
+				if strings.HasSuffix(cell, `\`) {
+					cell += " "
+				}
+				result.WriteString("|" + cell)
+			case pad:
+				result.WriteString("| " + padText(cell, widths[i], data.Align[data.Headers[i]]) + " ")
+			default:
+				result.WriteString("| " + cell + " ")
+			}
+		}
+		result.WriteString("|\n")
 	}
-	output[0] = headerRow
 
-	// Add data rows
-	copy(output[1:], data.Rows)
+	// Write headers and the delimiter row
+	writeRow(header)
+	delimiter := make([]string, len(data.Headers))
+	for i, h := range data.Headers {
+		dashes := widths[i]
+		switch data.Align[h] {
+		case parser.AlignLeft:
+			delimiter[i] = ":" + strings.Repeat("-", dashes-1)
+		case parser.AlignRight:
+			delimiter[i] = strings.Repeat("-", dashes-1) + ":"
+		case parser.AlignCenter:
+			delimiter[i] = ":" + strings.Repeat("-", dashes-2) + ":"
+		default:
+			delimiter[i] = strings.Repeat("-", dashes)
+		}
+	}
+	writeRow(delimiter)
 
-	jsonBytes, err := json.MarshalIndent(output, "", "  ")
-	if err != nil {
-		return "", err
+	// Write rows
+	for _, cells := range rows {
+		writeRow(cells)
 	}
 
-	return string(jsonBytes), nil
+	return result.String(), nil
 }
 
-func (r *MarkdownRenderer) Render(data *parser.TableData) (string, error) {
+// markdownEscape escapes a cell for a table: pipes, backslashes that would
+// escape the character after them and <br> tags written as text. Line
+// breaks become <br>.
+func markdownEscape(value string) string {
 	var result strings.Builder
-
-	// Write headers
-	result.WriteString("| ")
-	result.WriteString(strings.Join(data.Headers, " | "))
-	result.WriteString(" |\n")
-
-	// Write separator
-	result.WriteString("| ")
-	for range data.Headers {
-		result.WriteString("--- | ")
-	}
-	result.WriteString("\n")
-
-	// Write rows
-	for _, row := range data.Rows {
-		result.WriteString("| ")
-		for _, h := range data.Headers {
-			result.WriteString(row[h] + " | ")
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case c == '|':
+			result.WriteString(`\|`)
+		case c == '\\' && (i+1 == len(value) || isASCIIPunct(value[i+1]) || value[i+1] == '\n' || value[i+1] == '\r'):
+			result.WriteString(`\\`)
+		case c == '<' && markdownLineBreak.MatchString(value[i:]):
+			result.WriteString(`\<`)
+		case c == '\r' && i+1 < len(value) && value[i+1] == '\n':
+		case c == '\n' || c == '\r':
+			result.WriteString("<br>")
+		default:
+			result.WriteByte(c)
 		}
-		result.WriteString("\n")
 	}
+	return result.String()
+}
 
-	return result.String(), nil
+// markdownLineBreak matches a <br> tag, which MarkdownParser reads as a
+// line break
+var markdownLineBreak = regexp.MustCompile(`(?i)^<br\s*/?>`)
+
+// isASCIIPunct reports whether a backslash before c escapes it in Markdown
+func isASCIIPunct(c byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", c) >= 0
 }
 
 // Helper functions
@@ -174,10 +279,12 @@ func getColumnWidths(data *parser.TableData) map[string]int {
 	}
 
 	// Check all rows for maximum width
-	for _, row := range data.Rows {
-		for _, h := range data.Headers {
-			if width := len(row[h]); width > widths[h] {
-				widths[h] = width
+	for _, rows := range [][]map[string]string{data.Rows, data.Footer} {
+		for _, row := range rows {
+			for _, h := range data.Headers {
+				if width := len(row[h]); width > widths[h] {
+					widths[h] = width
+				}
 			}
 		}
 	}
@@ -185,13 +292,14 @@ func getColumnWidths(data *parser.TableData) map[string]int {
 	return widths
 }
 
-func createSeparator(headers []string, widths map[string]int) string {
-	var sep strings.Builder
-	sep.WriteString("+")
-	for _, h := range headers {
-		sep.WriteString(strings.Repeat("-", widths[h]+2))
-		sep.WriteString("+")
+// withFooter returns the table with its footer rows appended to the body,
+// for formats that have no place for a footer
+func withFooter(data *parser.TableData) *parser.TableData {
+	if len(data.Footer) == 0 {
+		return data
 	}
-	sep.WriteString("\n")
-	return sep.String()
+	flat := *data
+	flat.Rows = append(append([]map[string]string{}, data.Rows...), data.Footer...)
+	flat.Footer = nil
+	return &flat
 }
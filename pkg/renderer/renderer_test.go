@@ -2,7 +2,11 @@ package renderer
 
 import (
 	"reflect"
+	"regexp"
+	"strings"
 	"testing"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
 )
 
 func TestNewRenderer(t *testing.T) {
@@ -14,10 +18,28 @@ func TestNewRenderer(t *testing.T) {
 	}{
 		{"ASCII Renderer", "ascii", "*renderer.ASCIIRenderer", false},
 		{"CSV Renderer", "csv", "*renderer.CSVRenderer", false},
+		{"TSV Renderer", "tsv", "*renderer.CSVRenderer", false},
 		{"JSON Renderer", "json", "*renderer.JSONRenderer", false},
+		{"JSON Lines Renderer", "jsonl", "*renderer.JSONLRenderer", false},
 		{"HTML Renderer", "html", "*renderer.HTMLRenderer", false},
 		{"Excel Renderer", "xlsx", "*renderer.ExcelRenderer", false},
 		{"PNG Renderer", "png", "*renderer.ImageRenderer", false},
+		{"XML Renderer", "xml", "*renderer.XMLRenderer", false},
+		{"Fixed-width Renderer", "fixed", "*renderer.FixedWidthRenderer", false},
+		{"YAML Renderer", "yaml", "*renderer.YAMLRenderer", false},
+		{"TOML Renderer", "toml", "*renderer.TOMLRenderer", false},
+		{"SQLite Renderer", "sqlite", "*renderer.SQLiteRenderer", false},
+		{"SQL Renderer", "sql", "*renderer.SQLRenderer", false},
+		{"Parquet Renderer", "parquet", "*renderer.ParquetRenderer", false},
+		{"Arrow Renderer", "arrow", "*renderer.ArrowRenderer", false},
+		{"LaTeX Renderer", "latex", "*renderer.LaTeXRenderer", false},
+		{"reStructuredText Renderer", "rst", "*renderer.RSTRenderer", false},
+		{"AsciiDoc Renderer", "adoc", "*renderer.AsciiDocRenderer", false},
+		{"Org Renderer", "org", "*renderer.OrgRenderer", false},
+		{"Jira Renderer", "jira", "*renderer.JiraRenderer", false},
+		{"Confluence Renderer", "confluence", "*renderer.ConfluenceRenderer", false},
+		{"MediaWiki Renderer", "mediawiki", "*renderer.MediaWikiRenderer", false},
+		{"Template Renderer", "template", "*renderer.TemplateRenderer", false},
 		{"Invalid Renderer", "invalid", "", true},
 	}
 
@@ -34,3 +56,278 @@ func TestNewRenderer(t *testing.T) {
 		})
 	}
 }
+
+func TestMarkdownRenderer_RichCells(t *testing.T) {
+	data := &parser.TableData{
+		Headers: []string{"Name", "Notes"},
+		Rows: []map[string]string{
+			{"Name": "Ada", "Notes": "First programmer\nwrote notes"},
+		},
+		Rich: []map[string]parser.RichText{
+			{
+				"Name": {{Text: "Ada", Link: "https://example.com/ada"}},
+				"Notes": {
+					{Text: "First "},
+					{Text: "programmer ", Bold: true},
+					{Text: "\nwrote "},
+					{Text: "notes", Code: true},
+				},
+			},
+		},
+	}
+
+	got, err := (&MarkdownRenderer{}).Render(data)
+	if err != nil {
+		t.Fatalf("MarkdownRenderer.Render() error = %v", err)
+	}
+	want := "| [Ada](https://example.com/ada) | First **programmer** <br>wrote `notes` |"
+	if !strings.Contains(got, want) {
+		t.Errorf("MarkdownRenderer.Render() = %q, want row %q", got, want)
+	}
+	checkGFMTable(t, got, len(data.Headers))
+}
+
+func TestASCIIRenderer_Layout(t *testing.T) {
+	data := &parser.TableData{
+		Headers: []string{"name", "note"},
+		Rows: []map[string]string{
+			{"name": "李小龙", "note": "two\nlines"},
+			{"name": "Ann", "note": "ok"},
+		},
+		Align: map[string]parser.Alignment{"name": parser.AlignRight},
+	}
+
+	got, err := (&ASCIIRenderer{}).Render(data)
+	if err != nil {
+		t.Fatalf("ASCIIRenderer.Render() error = %v", err)
+	}
+	want := "+--------+-------+\n" +
+		"|   name | note  |\n" +
+		"+========+=======+\n" +
+		"| 李小龙 | two   |\n" +
+		"|        | lines |\n" +
+		"+--------+-------+\n" +
+		"|    Ann | ok    |\n" +
+		"+--------+-------+\n"
+	if got != want {
+		t.Errorf("ASCIIRenderer.Render() = %q, want %q", got, want)
+	}
+}
+
+func TestASCIIRenderer_Footer(t *testing.T) {
+	data := &parser.TableData{
+		Headers: []string{"item", "qty"},
+		Rows:    []map[string]string{{"item": "tea", "qty": "2"}},
+		Footer:  []map[string]string{{"item": "total", "qty": "2"}},
+	}
+
+	got, err := (&ASCIIRenderer{}).Render(data)
+	if err != nil {
+		t.Fatalf("ASCIIRenderer.Render() error = %v", err)
+	}
+	want := "+-------+-----+\n" +
+		"| item  | qty |\n" +
+		"+-------+-----+\n" +
+		"| tea   | 2   |\n" +
+		"+=======+=====+\n" +
+		"| total | 2   |\n" +
+		"+-------+-----+\n"
+	if got != want {
+		t.Errorf("ASCIIRenderer.Render() = %q, want %q", got, want)
+	}
+
+	parsed, err := (&parser.ASCIIParser{}).Parse([]byte(got))
+	if err != nil {
+		t.Fatalf("ASCIIParser.Parse() error = %v", err)
+	}
+	if !reflect.DeepEqual(parsed.Rows, data.Rows) || !reflect.DeepEqual(parsed.Footer, data.Footer) {
+		t.Errorf("Round trip = %v / %v, want %v / %v", parsed.Rows, parsed.Footer, data.Rows, data.Footer)
+	}
+}
+
+func TestASCIIRenderer_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		data *parser.TableData
+	}{
+		{
+			name: "Multi-line Cell",
+			data: &parser.TableData{
+				Headers: []string{"a", "b"},
+				Rows:    []map[string]string{{"a": "x\ny", "b": "1"}, {"a": "z", "b": "2"}},
+			},
+		},
+		{
+			name: "Single Multi-line Row",
+			data: &parser.TableData{
+				Headers: []string{"a", "b"},
+				Rows:    []map[string]string{{"a": "x\ny", "b": "1"}},
+			},
+		},
+		{
+			name: "Multi-line Footer",
+			data: &parser.TableData{
+				Headers: []string{"a", "b"},
+				Rows:    []map[string]string{{"a": "x", "b": "1"}, {"a": "z", "b": "2"}},
+				Footer:  []map[string]string{{"a": "sum\nof all", "b": "3"}, {"a": "max", "b": "2"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := (&ASCIIRenderer{}).Render(tt.data)
+			if err != nil {
+				t.Fatalf("ASCIIRenderer.Render() error = %v", err)
+			}
+			got, err := (&parser.ASCIIParser{}).Parse([]byte(output))
+			if err != nil {
+				t.Fatalf("ASCIIParser.Parse() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.Rows, tt.data.Rows) || !reflect.DeepEqual(got.Footer, tt.data.Footer) {
+				t.Errorf("round trip = %v / %v, want %v / %v\n%s", got.Rows, got.Footer, tt.data.Rows, tt.data.Footer, output)
+			}
+		})
+	}
+}
+
+func TestMarkdownRenderer_Render(t *testing.T) {
+	data := &parser.TableData{
+		Headers: []string{"Name", "Qty", "Note"},
+		Rows: []map[string]string{
+			{"Name": "a|b", "Qty": "5", "Note": "one\ntwo"},
+			{"Name": `C:\tmp\`, "Qty": "12", "Note": `x\|y`},
+			{"Name": "李", "Note": ""},
+		},
+		Align: map[string]parser.Alignment{"Qty": parser.AlignRight, "Note": parser.AlignCenter},
+	}
+
+	tests := []struct {
+		name     string
+		renderer *MarkdownRenderer
+		want     string
+	}{
+		{
+			name:     "Default",
+			renderer: &MarkdownRenderer{},
+			want: "| Name | Qty | Note |\n" +
+				"| --- | --: | :-: |\n" +
+				`| a\|b | 5 | one<br>two |` + "\n" +
+				`| C:\tmp\\ | 12 | x\\\|y |` + "\n" +
+				"| 李 |  |  |\n",
+		},
+		{
+			name:     "Padded",
+			renderer: &MarkdownRenderer{Pad: true},
+			want: "| Name     | Qty |    Note    |\n" +
+				"| -------- | --: | :--------: |\n" +
+				`| a\|b     |   5 | one<br>two |` + "\n" +
+				`| C:\tmp\\ |  12 |   x\\\|y   |` + "\n" +
+				"| 李       |     |            |\n",
+		},
+		{
+			name:     "Compact",
+			renderer: &MarkdownRenderer{Compact: true, Pad: true},
+			want: "|Name|Qty|Note|\n" +
+				"|---|--:|:-:|\n" +
+				`|a\|b|5|one<br>two|` + "\n" +
+				`|C:\tmp\\ |12|x\\\|y|` + "\n" +
+				"|李|||\n",
+		},
+	}
+
+	wantRows := []map[string]string{
+		{"Name": "a|b", "Qty": "5", "Note": "one\ntwo"},
+		{"Name": `C:\tmp\`, "Qty": "12", "Note": `x\|y`},
+		{"Name": "李", "Qty": "", "Note": ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.renderer.Render(data)
+			if err != nil {
+				t.Fatalf("MarkdownRenderer.Render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("MarkdownRenderer.Render() = %q, want %q", got, tt.want)
+			}
+			checkGFMTable(t, got, len(data.Headers))
+
+			table, err := (&parser.MarkdownParser{}).Parse([]byte(got))
+			if err != nil {
+				t.Fatalf("MarkdownParser.Parse() error = %v", err)
+			}
+			if !reflect.DeepEqual(table.Rows, wantRows) || !reflect.DeepEqual(table.Align, data.Align) {
+				t.Errorf("MarkdownParser.Parse() = %q %v, want %q %v", table.Rows, table.Align, wantRows, data.Align)
+			}
+		})
+	}
+}
+
+func TestMarkdownRenderer_LineBreakTags(t *testing.T) {
+	data := &parser.TableData{
+		Headers: []string{"html", "path"},
+		Rows: []map[string]string{
+			{"html": "a<br>b <BR/> c", "path": "C:\\\nD:"},
+		},
+	}
+
+	got, err := (&MarkdownRenderer{}).Render(data)
+	if err != nil {
+		t.Fatalf("MarkdownRenderer.Render() error = %v", err)
+	}
+	want := "| html | path |\n| --- | --- |\n" + `| a\<br>b \<BR/> c | C:\\<br>D: |` + "\n"
+	if got != want {
+		t.Errorf("MarkdownRenderer.Render() = %q, want %q", got, want)
+	}
+
+	table, err := (&parser.MarkdownParser{}).Parse([]byte(got))
+	if err != nil {
+		t.Fatalf("MarkdownParser.Parse() error = %v", err)
+	}
+	if !reflect.DeepEqual(table.Rows, data.Rows) {
+		t.Errorf("MarkdownParser.Parse() = %q, want %q", table.Rows, data.Rows)
+	}
+}
+
+// checkGFMTable checks output against the GitHub Flavored Markdown table
+// grammar: every line is a row of columns cells enclosed in pipes, a pipe
+// after a backslash does not end a cell and the second row is the
+// delimiter row.
+func checkGFMTable(t *testing.T, output string, columns int) {
+	t.Helper()
+	delimiterCell := regexp.MustCompile(`^ *:?-+:? *$`)
+
+	lines := strings.Split(strings.TrimSuffix(output, "\n"), "\n")
+	if len(lines) < 2 {
+		t.Fatalf("table has %d lines, want a header and a delimiter row", len(lines))
+	}
+	for n, line := range lines {
+		if line != strings.TrimRight(line, " \t") {
+			t.Errorf("line %d has trailing whitespace: %q", n+1, line)
+		}
+		if !strings.HasPrefix(line, "|") || !strings.HasSuffix(line, "|") || strings.HasSuffix(line, `\|`) {
+			t.Errorf("line %d is not enclosed in pipes: %q", n+1, line)
+			continue
+		}
+
+		var cells []string
+		start := 1
+		for i := 1; i < len(line); i++ {
+			if line[i] == '|' && line[i-1] != '\\' {
+				cells = append(cells, line[start:i])
+				start = i + 1
+			}
+		}
+		if len(cells) != columns {
+			t.Errorf("line %d has %d cells, want %d: %q", n+1, len(cells), columns, line)
+		}
+		if n == 1 {
+			for _, cell := range cells {
+				if !delimiterCell.MatchString(cell) {
+					t.Errorf("delimiter row cell %q is not :?-+:?", cell)
+				}
+			}
+		}
+	}
+}
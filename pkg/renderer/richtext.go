@@ -0,0 +1,74 @@
+package renderer
+
+import (
+	"strings"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+// richMarkdown converts formatted cell content to inline Markdown for a table
+// cell. Links become [text](url), emphasis uses ** * and backticks, line
+// breaks become <br> and pipes are escaped.
+func richMarkdown(rt parser.RichText) string {
+	var result strings.Builder
+	for start := 0; start < len(rt); {
+		// Group consecutive spans sharing a link so it is written once
+		end := start + 1
+		for end < len(rt) && rt[end].Link == rt[start].Link {
+			end++
+		}
+
+		var inner strings.Builder
+		for _, span := range rt[start:end] {
+			for i, line := range strings.Split(span.Text, "\n") {
+				if i > 0 {
+					inner.WriteString("<br>")
+				}
+				inner.WriteString(markdownSpan(span, strings.TrimSuffix(line, "\r")))
+			}
+		}
+
+		if link := rt[start].Link; link != "" {
+			result.WriteString("[" + inner.String() + "](" + strings.NewReplacer(" ", "%20", "|", "%7C").Replace(link) + ")")
+		} else {
+			result.WriteString(inner.String())
+		}
+		start = end
+	}
+	return result.String()
+}
+
+// markdownSpan wraps a single line of a span in emphasis markers, keeping
+// surrounding spaces outside the markers so the Markdown stays valid
+func markdownSpan(span parser.Span, text string) string {
+	return wrapSpan(text, func(core string) string {
+		if span.Code {
+			// Backslashes are literal in code spans but pipes still end the cell
+			core = strings.ReplaceAll(core, "|", `\|`)
+			if strings.Contains(core, "`") {
+				return "`` " + core + " ``"
+			}
+			return "`" + core + "`"
+		}
+		core = markdownEscape(core)
+		if span.Italic {
+			core = "*" + core + "*"
+		}
+		if span.Bold {
+			core = "**" + core + "**"
+		}
+		return core
+	})
+}
+
+// wrapSpan applies wrap to text without its surrounding spaces, which stay
+// outside the markers
+func wrapSpan(text string, wrap func(core string) string) string {
+	core := strings.TrimSpace(text)
+	if core == "" {
+		return text
+	}
+	lead := text[:strings.Index(text, core)]
+	trail := text[len(lead)+len(core):]
+	return lead + wrap(core) + trail
+}
@@ -0,0 +1,147 @@
+package renderer
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+// RSTRenderer implements Renderer for reStructuredText tables. Cells with
+// several lines are written as line blocks so their line breaks are kept.
+type RSTRenderer struct {
+	// Simple writes a simple table instead of a grid table. Simple tables
+	// cannot hold several lines in their first column, so tables that do
+	// are still written as grid tables.
+	Simple bool
+	// Caption and Label wrap the table in a table directive with that title
+	// and name
+	Caption string
+	Label   string
+}
+
+func (r *RSTRenderer) Render(data *parser.TableData) (string, error) {
+	layout := newTextLayout(data, rstText, false)
+	rows := append(append([][][]string{}, layout.rows...), layout.footer...)
+
+	var lines []string
+	if r.Simple && rstSimpleFits(layout.header, rows) {
+		lines = rstSimpleTable(layout, rows)
+	} else {
+		lines = rstGridTable(layout, rows)
+	}
+
+	indent := ""
+	var result strings.Builder
+	if r.Caption != "" || r.Label != "" {
+		result.WriteString(".. table::")
+		if r.Caption != "" {
+			result.WriteString(" " + strings.ReplaceAll(r.Caption, "\n", " "))
+		}
+		result.WriteString("\n")
+		if r.Label != "" {
+			result.WriteString("   :name: " + r.Label + "\n")
+		}
+		result.WriteString("\n")
+		indent = "   "
+	}
+	for _, line := range lines {
+		result.WriteString(indent + line + "\n")
+	}
+	return result.String(), nil
+}
+
+// rstGridTable draws a grid table, with the header marked by a rule of =
+func rstGridTable(layout *textLayout, rows [][][]string) []string {
+	rule := layout.rule("+", "-", "+", "+")
+	lines := []string{rule}
+	lines = append(lines, layout.lines(layout.header, "|", "|", "|")...)
+	if len(rows) == 0 {
+		return append(lines, rule)
+	}
+	lines = append(lines, layout.rule("+", "=", "+", "+"))
+	for _, cells := range rows {
+		lines = append(lines, layout.lines(cells, "|", "|", "|")...)
+		lines = append(lines, rule)
+	}
+	return lines
+}
+
+// rstSimpleTable draws a simple table. Lines with an empty first column
+// continue the row above, so empty first cells are written as an escaped
+// space.
+func rstSimpleTable(layout *textLayout, rows [][][]string) []string {
+	layout.pad = 0
+	rule := layout.rule("", "=", "  ", "")
+	lines := []string{rule}
+	write := func(cells [][]string) {
+		if len(cells) > 0 && len(cells[0]) == 1 && cells[0][0] == "" {
+			cells = append([][]string{{`\`}}, cells[1:]...)
+		}
+		for _, line := range layout.lines(cells, "", "  ", "") {
+			line = strings.TrimRight(line, " ")
+			if strings.HasSuffix(line, `\`) {
+				line += " "
+			}
+			lines = append(lines, line)
+		}
+	}
+	write(layout.header)
+	lines = append(lines, rule)
+	for _, cells := range rows {
+		write(cells)
+	}
+	return append(lines, rule)
+}
+
+// rstSimpleFits reports whether every first cell of the table is one line
+func rstSimpleFits(header [][]string, rows [][][]string) bool {
+	if len(header) == 0 {
+		return false
+	}
+	for _, cells := range append([][][]string{header}, rows...) {
+		if len(cells[0]) > 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// rstText escapes inline markup in a cell and turns a cell with several
+// lines into a line block
+func rstText(value string) string {
+	lines := cellLines(value)
+	for i, line := range lines {
+		lines[i] = rstEscape(line)
+	}
+	if len(lines) == 1 {
+		return lines[0]
+	}
+	for i, line := range lines {
+		if line == "" {
+			lines[i] = "|"
+		} else {
+			lines[i] = "| " + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// rstEscape escapes the characters that start inline markup, and
+// underscores that would end a reference
+func rstEscape(value string) string {
+	runes := []rune(value)
+	var result strings.Builder
+	for i, c := range runes {
+		switch c {
+		case '\\', '*', '`', '|':
+			result.WriteRune('\\')
+		case '_':
+			if i == len(runes)-1 || !(unicode.IsLetter(runes[i+1]) || unicode.IsDigit(runes[i+1])) {
+				result.WriteRune('\\')
+			}
+		}
+		result.WriteRune(c)
+	}
+	return result.String()
+}
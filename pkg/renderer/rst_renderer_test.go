@@ -0,0 +1,79 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+func TestRSTRenderer_Render(t *testing.T) {
+	data := &parser.TableData{
+		Headers: []string{"id", "name", "note"},
+		Rows: []map[string]string{
+			{"id": "1", "name": "Ann", "note": "two\nlines"},
+			{"name": "李小龙", "note": "*x* ref_"},
+		},
+	}
+	multiline := &parser.TableData{
+		Headers: []string{"a", "b"},
+		Rows:    []map[string]string{{"a": "x\ny", "b": "z"}},
+	}
+
+	tests := []struct {
+		name     string
+		renderer *RSTRenderer
+		data     *parser.TableData
+		want     string
+	}{
+		{
+			name:     "Grid",
+			renderer: &RSTRenderer{},
+			data:     data,
+			want: "+----+--------+-------------+\n" +
+				"| id | name   | note        |\n" +
+				"+====+========+=============+\n" +
+				"| 1  | Ann    | | two       |\n" +
+				"|    |        | | lines     |\n" +
+				"+----+--------+-------------+\n" +
+				"|    | 李小龙 | \\*x\\* ref\\_ |\n" +
+				"+----+--------+-------------+\n",
+		},
+		{
+			name:     "Simple",
+			renderer: &RSTRenderer{Simple: true},
+			data:     data,
+			want: "==  ======  ===========\n" +
+				"id  name    note\n" +
+				"==  ======  ===========\n" +
+				"1   Ann     | two\n" +
+				"            | lines\n" +
+				"\\   李小龙  \\*x\\* ref\\_\n" +
+				"==  ======  ===========\n",
+		},
+		{
+			name:     "Simple Falls Back To Grid",
+			renderer: &RSTRenderer{Simple: true, Caption: "Pairs", Label: "pairs"},
+			data:     multiline,
+			want: ".. table:: Pairs\n" +
+				"   :name: pairs\n\n" +
+				"   +-----+---+\n" +
+				"   | a   | b |\n" +
+				"   +=====+===+\n" +
+				"   | | x | z |\n" +
+				"   | | y |   |\n" +
+				"   +-----+---+\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.renderer.Render(tt.data)
+			if err != nil {
+				t.Fatalf("RSTRenderer.Render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("RSTRenderer.Render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package renderer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+// SQLDialect selects the SQL syntax a script is written in
+type SQLDialect string
+
+const (
+	DialectPostgres  SQLDialect = "postgres"
+	DialectMySQL     SQLDialect = "mysql"
+	DialectSQLite    SQLDialect = "sqlite"
+	DialectSQLServer SQLDialect = "sqlserver"
+)
+
+// ParseSQLDialect looks up a dialect by name, accepting common aliases
+func ParseSQLDialect(name string) (SQLDialect, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "postgres", "postgresql", "pg":
+		return DialectPostgres, nil
+	case "mysql", "mariadb":
+		return DialectMySQL, nil
+	case "sqlite", "sqlite3":
+		return DialectSQLite, nil
+	case "sqlserver", "mssql", "tsql":
+		return DialectSQLServer, nil
+	}
+	return "", fmt.Errorf("unknown SQL dialect %q: use postgres, mysql, sqlite or sqlserver", name)
+}
+
+// quoteIdent quotes a table or column name
+func (d SQLDialect) quoteIdent(name string) string {
+	switch d {
+	case DialectMySQL:
+		return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+	case DialectSQLServer:
+		return "[" + strings.ReplaceAll(name, "]", "]]") + "]"
+	}
+	return quoteIdent(name)
+}
+
+// quoteString writes a string literal. MySQL reads backslashes as escapes
+// and SQL Server needs an N prefix to keep characters outside its code page.
+func (d SQLDialect) quoteString(value string) string {
+	switch d {
+	case DialectMySQL:
+		value = strings.NewReplacer(`\`, `\\`, "'", "''", "\x00", `\0`, "\x1a", `\Z`).Replace(value)
+		return "'" + value + "'"
+	case DialectSQLServer:
+		value = "'" + strings.ReplaceAll(value, "'", "''") + "'"
+		for _, r := range value {
+			if r > 0x7f {
+				return "N" + value
+			}
+		}
+		return value
+	}
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+func (d SQLDialect) typeName(kind parser.ColumnType) string {
+	switch kind {
+	case parser.TypeInteger:
+		if d == DialectSQLite {
+			return "INTEGER"
+		}
+		return "BIGINT"
+	case parser.TypeFloat:
+		switch d {
+		case DialectPostgres:
+			return "DOUBLE PRECISION"
+		case DialectMySQL:
+			return "DOUBLE"
+		case DialectSQLServer:
+			return "FLOAT"
+		}
+		return "REAL"
+	case parser.TypeBoolean:
+		if d == DialectSQLServer {
+			return "BIT"
+		}
+		return "BOOLEAN"
+	}
+	if d == DialectSQLServer {
+		return "NVARCHAR(MAX)"
+	}
+	return "TEXT"
+}
+
+// maxRows is the most rows the dialect takes in one INSERT, 0 for no limit
+func (d SQLDialect) maxRows() int {
+	if d == DialectSQLServer {
+		return 1000
+	}
+	return 0
+}
+
+// SQLRenderer implements Renderer for SQL scripts: an optional CREATE TABLE
+// statement followed by INSERT statements. Column types come from the data
+// as for SQLiteRenderer; missing cells are written as NULL.
+type SQLRenderer struct {
+	// Dialect selects identifier quoting, string escaping and column types,
+	// DialectPostgres by default
+	Dialect SQLDialect
+	// Table names the table, by default the table's own name or "data"
+	Table string
+	// CreateTable writes a CREATE TABLE statement before the inserts
+	CreateTable bool
+	// BatchSize is the number of rows per INSERT statement, 100 by default
+	BatchSize int
+}
+
+func (r *SQLRenderer) Render(data *parser.TableData) (string, error) {
+	data = withFooter(data)
+	dialect, err := ParseSQLDialect(string(r.Dialect))
+	if err != nil {
+		return "", err
+	}
+	if len(data.Headers) == 0 {
+		return "", fmt.Errorf("no columns to write")
+	}
+
+	name := r.Table
+	if name == "" {
+		name = strings.TrimSpace(data.Name)
+	}
+	if name == "" {
+		name = "data"
+	}
+	table := dialect.quoteIdent(name)
+
+	kinds := make([]parser.ColumnType, len(data.Headers))
+	columns := make([]string, len(data.Headers))
+	for i, h := range data.Headers {
+		kinds[i] = inferColumnType(data, h)
+		columns[i] = dialect.quoteIdent(h)
+	}
+
+	var result strings.Builder
+	if r.CreateTable {
+		result.WriteString("CREATE TABLE " + table + " (\n")
+		for i, column := range columns {
+			result.WriteString("  " + column + " " + dialect.typeName(kinds[i]))
+			if i < len(columns)-1 {
+				result.WriteString(",")
+			}
+			result.WriteString("\n")
+		}
+		result.WriteString(");\n")
+		if len(data.Rows) > 0 {
+			result.WriteString("\n")
+		}
+	}
+
+	batch := r.BatchSize
+	if batch <= 0 {
+		batch = 100
+	}
+	if limit := dialect.maxRows(); limit > 0 {
+		batch = min(batch, limit)
+	}
+
+	insert := "INSERT INTO " + table + " (" + strings.Join(columns, ", ") + ") VALUES"
+	for start := 0; start < len(data.Rows); start += batch {
+		rows := data.Rows[start:min(start+batch, len(data.Rows))]
+		result.WriteString(insert)
+		if len(rows) > 1 {
+			result.WriteString("\n  ")
+		} else {
+			result.WriteString(" ")
+		}
+		for n, row := range rows {
+			values := make([]string, len(data.Headers))
+			for i, h := range data.Headers {
+				values[i] = r.literal(dialect, kinds[i], row, h)
+			}
+			if n > 0 {
+				result.WriteString(",\n  ")
+			}
+			result.WriteString("(" + strings.Join(values, ", ") + ")")
+		}
+		result.WriteString(";\n")
+	}
+
+	return result.String(), nil
+}
+
+// literal writes a cell as a SQL value. Missing cells, and empty ones in
+// typed columns, are NULL; values that do not fit the column type are
+// written as strings.
+func (r *SQLRenderer) literal(dialect SQLDialect, kind parser.ColumnType, row map[string]string, header string) string {
+	value, ok := row[header]
+	if !ok || (value == "" && kind != parser.TypeString) {
+		return "NULL"
+	}
+
+	switch kind {
+	case parser.TypeInteger, parser.TypeFloat:
+		if isJSONNumber(value) {
+			return value
+		}
+	case parser.TypeBoolean:
+		if value == "true" || value == "false" {
+			switch dialect {
+			case DialectSQLite, DialectSQLServer:
+				if value == "true" {
+					return "1"
+				}
+				return "0"
+			}
+			return strings.ToUpper(value)
+		}
+	}
+	return dialect.quoteString(value)
+}
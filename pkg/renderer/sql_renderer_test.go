@@ -0,0 +1,97 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+func TestSQLRenderer_Render(t *testing.T) {
+	data := &parser.TableData{
+		Name:    "people",
+		Headers: []string{"id", "name", "active"},
+		Rows: []map[string]string{
+			{"id": "1", "name": `O'Brien \ Zoë`, "active": "true"},
+			{"id": "2", "active": "false"},
+			{"id": "3", "name": "Ann"},
+		},
+		Types: map[string]parser.ColumnType{"id": parser.TypeInteger, "active": parser.TypeBoolean},
+	}
+
+	tests := []struct {
+		name     string
+		renderer *SQLRenderer
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "PostgreSQL",
+			renderer: &SQLRenderer{CreateTable: true},
+			want: `CREATE TABLE "people" (
+  "id" BIGINT,
+  "name" TEXT,
+  "active" BOOLEAN
+);
+
+INSERT INTO "people" ("id", "name", "active") VALUES
+  (1, 'O''Brien \ Zoë', TRUE),
+  (2, NULL, FALSE),
+  (3, 'Ann', NULL);
+`,
+		},
+		{
+			name:     "MySQL Batches",
+			renderer: &SQLRenderer{Dialect: DialectMySQL, Table: "users", BatchSize: 2},
+			want: "INSERT INTO `users` (`id`, `name`, `active`) VALUES\n" +
+				"  (1, 'O''Brien \\\\ Zoë', TRUE),\n" +
+				"  (2, NULL, FALSE);\n" +
+				"INSERT INTO `users` (`id`, `name`, `active`) VALUES (3, 'Ann', NULL);\n",
+		},
+		{
+			name:     "SQLite",
+			renderer: &SQLRenderer{Dialect: DialectSQLite, CreateTable: true, BatchSize: 3},
+			want: `CREATE TABLE "people" (
+  "id" INTEGER,
+  "name" TEXT,
+  "active" BOOLEAN
+);
+
+INSERT INTO "people" ("id", "name", "active") VALUES
+  (1, 'O''Brien \ Zoë', 1),
+  (2, NULL, 0),
+  (3, 'Ann', NULL);
+`,
+		},
+		{
+			name:     "SQL Server",
+			renderer: &SQLRenderer{Dialect: DialectSQLServer, CreateTable: true, BatchSize: 1},
+			want: `CREATE TABLE [people] (
+  [id] BIGINT,
+  [name] NVARCHAR(MAX),
+  [active] BIT
+);
+
+INSERT INTO [people] ([id], [name], [active]) VALUES (1, N'O''Brien \ Zoë', 1);
+INSERT INTO [people] ([id], [name], [active]) VALUES (2, NULL, 0);
+INSERT INTO [people] ([id], [name], [active]) VALUES (3, 'Ann', NULL);
+`,
+		},
+		{
+			name:     "Unknown Dialect",
+			renderer: &SQLRenderer{Dialect: "oracle"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.renderer.Render(data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SQLRenderer.Render() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("SQLRenderer.Render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
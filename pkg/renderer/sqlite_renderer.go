@@ -0,0 +1,281 @@
+package renderer
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+	_ "modernc.org/sqlite"
+)
+
+// FileRenderer is implemented by renderers that write into an existing
+// file, such as a database, instead of replacing its content
+type FileRenderer interface {
+	RenderFile(path string, tables []*parser.TableData) error
+}
+
+// ExistsMode says what to do with an output table that already exists
+type ExistsMode string
+
+const (
+	// ExistsFail stops with an error
+	ExistsFail ExistsMode = "fail"
+	// ExistsReplace drops the table and creates it again
+	ExistsReplace ExistsMode = "replace"
+	// ExistsAppend adds the rows to the table
+	ExistsAppend ExistsMode = "append"
+)
+
+// sqliteMaxVariables is the most parameters SQLite takes in one statement
+const sqliteMaxVariables = 32766
+
+// SQLiteRenderer implements Renderer for SQLite databases. Each table is
+// created with column types taken from the data and filled with batched
+// inserts inside a single transaction.
+type SQLiteRenderer struct {
+	// Table names the output table. By default it is the table's own name,
+	// or "data" when it has none.
+	Table string
+	// IfExists is the mode for a table that already exists, ExistsFail by default
+	IfExists ExistsMode
+	// BatchSize is the number of rows inserted per statement, 500 by default
+	BatchSize int
+}
+
+func (r *SQLiteRenderer) Render(data *parser.TableData) (string, error) {
+	return r.RenderAll([]*parser.TableData{data})
+}
+
+// RenderAll writes each table to a new database and returns its content
+func (r *SQLiteRenderer) RenderAll(tables []*parser.TableData) (string, error) {
+	dir, err := os.MkdirTemp("", "gotable-")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "output.db")
+	if err := r.RenderFile(path, tables); err != nil {
+		return "", err
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+// RenderFile writes the tables into the database at path, creating it if
+// needed. Other tables in the database are left alone.
+func (r *SQLiteRenderer) RenderFile(path string, tables []*parser.TableData) error {
+	switch r.IfExists {
+	case "", ExistsFail, ExistsReplace, ExistsAppend:
+	default:
+		return fmt.Errorf("invalid table mode %q: use fail, replace or append", r.IfExists)
+	}
+
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	used := make(map[string]bool)
+	for i, data := range tables {
+		name := r.tableName(data, i, len(tables), used)
+		if err := r.writeTable(tx, name, data); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("table %s: %v", name, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// tableName picks a distinct name for each table written
+func (r *SQLiteRenderer) tableName(data *parser.TableData, index, count int, used map[string]bool) string {
+	name := r.Table
+	switch {
+	case name != "" && count > 1:
+		name = fmt.Sprintf("%s_%d", name, index+1)
+	case name == "":
+		name = strings.TrimSpace(data.Name)
+	}
+	if name == "" {
+		name = "data"
+	}
+
+	base := name
+	for n := 2; used[strings.ToLower(name)]; n++ {
+		name = fmt.Sprintf("%s_%d", base, n)
+	}
+	used[strings.ToLower(name)] = true
+	return name
+}
+
+func (r *SQLiteRenderer) writeTable(tx *sql.Tx, name string, data *parser.TableData) error {
+	data = withFooter(data)
+	if len(data.Headers) == 0 {
+		return fmt.Errorf("no columns to write")
+	}
+
+	var exists bool
+	err := tx.QueryRow(`SELECT count(*) > 0 FROM sqlite_master WHERE type = 'table' AND name = ?`, name).Scan(&exists)
+	if err != nil {
+		return err
+	}
+
+	kinds := make([]parser.ColumnType, len(data.Headers))
+	for i, h := range data.Headers {
+		kinds[i] = inferColumnType(data, h)
+	}
+
+	create := !exists
+	if exists {
+		switch r.IfExists {
+		case ExistsReplace:
+			if _, err := tx.Exec("DROP TABLE " + quoteIdent(name)); err != nil {
+				return err
+			}
+			create = true
+		case ExistsAppend:
+		default:
+			return fmt.Errorf("table already exists; replace or append to it instead")
+		}
+	}
+
+	// SQLite compares column names without case, so Name and name clash
+	columns := columnNames(data.Headers)
+	if create {
+		definitions := make([]string, len(columns))
+		for i, column := range columns {
+			definitions[i] = column + " " + sqliteTypeName(kinds[i])
+		}
+		if _, err := tx.Exec("CREATE TABLE " + quoteIdent(name) + " (" + strings.Join(definitions, ", ") + ")"); err != nil {
+			return err
+		}
+	}
+
+	batch := r.BatchSize
+	if batch <= 0 {
+		batch = 500
+	}
+	batch = max(1, min(batch, sqliteMaxVariables/len(data.Headers)))
+
+	placeholders := "(" + strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ") + ")"
+	insert := "INSERT INTO " + quoteIdent(name) + " (" + strings.Join(columns, ", ") + ") VALUES "
+
+	for start := 0; start < len(data.Rows); start += batch {
+		rows := data.Rows[start:min(start+batch, len(data.Rows))]
+		args := make([]any, 0, len(rows)*len(columns))
+		for _, row := range rows {
+			for i, h := range data.Headers {
+				args = append(args, sqlValue(kinds[i], row, h))
+			}
+		}
+		statement := insert + strings.TrimSuffix(strings.Repeat(placeholders+", ", len(rows)), ", ")
+		if _, err := tx.Exec(statement, args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// columnNames quotes the headers as column names, suffixing those that
+// differ from an earlier one only in case
+func columnNames(headers []string) []string {
+	used := make(map[string]bool)
+	columns := make([]string, len(headers))
+	for i, h := range headers {
+		name := h
+		for n := 2; used[strings.ToLower(name)]; n++ {
+			name = fmt.Sprintf("%s_%d", h, n)
+		}
+		used[strings.ToLower(name)] = true
+		columns[i] = quoteIdent(name)
+	}
+	return columns
+}
+
+// inferColumnType returns the type to store a column as. Columns without a
+// type from their source are numeric when every value is a plain number.
+func inferColumnType(data *parser.TableData, header string) parser.ColumnType {
+	if kind, ok := data.Types[header]; ok {
+		return kind
+	}
+
+	kind := parser.TypeString
+	for _, row := range data.Rows {
+		value := row[header]
+		if value == "" {
+			continue
+		}
+		if !isJSONNumber(value) {
+			return parser.TypeString
+		}
+		if _, err := strconv.ParseInt(value, 10, 64); err == nil {
+			if kind != parser.TypeFloat {
+				kind = parser.TypeInteger
+			}
+		} else {
+			kind = parser.TypeFloat
+		}
+	}
+	return kind
+}
+
+func sqliteTypeName(kind parser.ColumnType) string {
+	switch kind {
+	case parser.TypeInteger:
+		return "INTEGER"
+	case parser.TypeFloat:
+		return "REAL"
+	case parser.TypeBoolean:
+		return "BOOLEAN"
+	}
+	return "TEXT"
+}
+
+// sqlValue converts a cell to the value stored for it. Missing cells, and
+// empty ones in typed columns, are NULL; values that do not fit the column
+// type are stored as text.
+func sqlValue(kind parser.ColumnType, row map[string]string, header string) any {
+	value, ok := row[header]
+	if !ok || (value == "" && kind != parser.TypeString) {
+		return nil
+	}
+
+	switch kind {
+	case parser.TypeInteger:
+		if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return n
+		}
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+	case parser.TypeFloat:
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+	case parser.TypeBoolean:
+		switch value {
+		case "true":
+			return 1
+		case "false":
+			return 0
+		}
+	}
+	return value
+}
+
+// quoteIdent quotes a table or column name for SQL
+func quoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
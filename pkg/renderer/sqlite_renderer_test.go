@@ -0,0 +1,136 @@
+package renderer
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+func TestSQLiteRenderer_RenderFile(t *testing.T) {
+	data := &parser.TableData{
+		Name:    "people",
+		Headers: []string{"id", "name", "score", "zip"},
+		Rows: []map[string]string{
+			{"id": "1", "name": "John", "score": "9.5", "zip": "007"},
+			{"id": "2", "name": "Alice", "score": "", "zip": "123"},
+			{"id": "3", "name": "Bob"},
+		},
+	}
+	path := filepath.Join(t.TempDir(), "out.db")
+
+	read := func() *parser.TableData {
+		t.Helper()
+		content, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := (&parser.SQLiteParser{Table: "people"}).Parse(content)
+		if err != nil {
+			t.Fatalf("SQLiteParser.Parse() error = %v", err)
+		}
+		return got
+	}
+
+	// A batch size of 2 splits the rows over two statements
+	r := &SQLiteRenderer{BatchSize: 2}
+	if err := r.RenderFile(path, []*parser.TableData{data}); err != nil {
+		t.Fatalf("SQLiteRenderer.RenderFile() error = %v", err)
+	}
+	got := read()
+	wantRows := []map[string]string{
+		{"id": "1", "name": "John", "score": "9.5", "zip": "007"},
+		{"id": "2", "name": "Alice", "zip": "123"},
+		{"id": "3", "name": "Bob"},
+	}
+	if !reflect.DeepEqual(got.Rows, wantRows) {
+		t.Errorf("rows = %v, want %v", got.Rows, wantRows)
+	}
+	wantTypes := map[string]parser.ColumnType{"id": parser.TypeInteger, "score": parser.TypeFloat}
+	if !reflect.DeepEqual(got.Types, wantTypes) {
+		t.Errorf("types = %v, want %v", got.Types, wantTypes)
+	}
+
+	if err := r.RenderFile(path, []*parser.TableData{data}); err == nil {
+		t.Errorf("SQLiteRenderer.RenderFile() into an existing table should fail")
+	}
+
+	r.IfExists = ExistsAppend
+	if err := r.RenderFile(path, []*parser.TableData{data}); err != nil {
+		t.Fatalf("SQLiteRenderer.RenderFile() append error = %v", err)
+	}
+	if rows := len(read().Rows); rows != 6 {
+		t.Errorf("rows after append = %d, want 6", rows)
+	}
+
+	r.IfExists = ExistsReplace
+	if err := r.RenderFile(path, []*parser.TableData{data}); err != nil {
+		t.Fatalf("SQLiteRenderer.RenderFile() replace error = %v", err)
+	}
+	if rows := len(read().Rows); rows != 3 {
+		t.Errorf("rows after replace = %d, want 3", rows)
+	}
+}
+
+func TestSQLiteRenderer_RenderAll(t *testing.T) {
+	tables := []*parser.TableData{
+		{Headers: []string{"a"}, Rows: []map[string]string{{"a": "true"}}, Types: map[string]parser.ColumnType{"a": parser.TypeBoolean}},
+		{Headers: []string{"b"}, Rows: []map[string]string{{"b": "x"}}},
+	}
+
+	output, err := (&SQLiteRenderer{}).RenderAll(tables)
+	if err != nil {
+		t.Fatalf("SQLiteRenderer.RenderAll() error = %v", err)
+	}
+	got, err := (&parser.SQLiteParser{}).ParseAll([]byte(output))
+	if err != nil {
+		t.Fatalf("SQLiteParser.ParseAll() error = %v", err)
+	}
+	if len(got) != 2 || got[0].Name != "data" || got[1].Name != "data_2" {
+		t.Fatalf("tables = %v", got)
+	}
+	if got[0].Rows[0]["a"] != "true" || got[0].ColumnType("a") != parser.TypeBoolean {
+		t.Errorf("boolean column = %v %v", got[0].Rows, got[0].Types)
+	}
+}
+
+func TestSQLiteRenderer_Render(t *testing.T) {
+	tests := []struct {
+		name     string
+		renderer *SQLiteRenderer
+		data     *parser.TableData
+		want     *parser.TableData
+	}{
+		{
+			name:     "Headers Differing In Case",
+			renderer: &SQLiteRenderer{},
+			data: &parser.TableData{
+				Headers: []string{"Name", "name", "NAME_2"},
+				Rows:    []map[string]string{{"Name": "a", "name": "b", "NAME_2": "c"}},
+			},
+			want: &parser.TableData{
+				Name:    "data",
+				Headers: []string{"Name", "name_2", "NAME_2_2"},
+				Rows:    []map[string]string{{"Name": "a", "name_2": "b", "NAME_2_2": "c"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := tt.renderer.Render(tt.data)
+			if err != nil {
+				t.Fatalf("SQLiteRenderer.Render() error = %v", err)
+			}
+			got, err := (&parser.SQLiteParser{}).Parse([]byte(output))
+			if err != nil {
+				t.Fatalf("SQLiteParser.Parse() error = %v", err)
+			}
+			if got.Name != tt.want.Name || !reflect.DeepEqual(got.Headers, tt.want.Headers) || !reflect.DeepEqual(got.Rows, tt.want.Rows) {
+				t.Errorf("SQLiteRenderer.Render() = %v %v, want %v %v", got.Headers, got.Rows, tt.want.Headers, tt.want.Rows)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package renderer
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	htmltemplate "html/template"
+	"io"
+	"net/url"
+	"strings"
+	"text/template"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+// TemplateRenderer implements Renderer with a user supplied Go template. The
+// template runs on a TemplateTable, with helper functions for padding,
+// escaping and encoding values.
+type TemplateRenderer struct {
+	// Template is the template source
+	Template string
+	// Name names the template in error messages
+	Name string
+	// HTML executes the template with html/template, which escapes values
+	// for the context they appear in
+	HTML bool
+}
+
+// TemplateTable is the value a template runs on. It has all the fields of
+// the table, such as .Headers, .Rows, .Footer and .Types, and its methods.
+type TemplateTable struct {
+	*parser.TableData
+}
+
+// Cells returns the values of a row in header order
+func (t TemplateTable) Cells(row map[string]string) []string {
+	cells := make([]string, len(t.Headers))
+	for i, h := range t.Headers {
+		cells[i] = row[h]
+	}
+	return cells
+}
+
+// Index returns the position of a column, or -1 if there is none
+func (t TemplateTable) Index(header string) int {
+	for i, h := range t.Headers {
+		if h == header {
+			return i
+		}
+	}
+	return -1
+}
+
+// Width returns the display width of the widest value in a column,
+// including its header
+func (t TemplateTable) Width(header string) int {
+	width := textWidth(header)
+	for _, rows := range [][]map[string]string{t.Rows, t.Footer} {
+		for _, row := range rows {
+			for _, line := range cellLines(row[header]) {
+				width = max(width, textWidth(line))
+			}
+		}
+	}
+	return width
+}
+
+// templateFuncs are the helper functions templates can call
+var templateFuncs = map[string]any{
+	"pad": func(width int, value string) string {
+		return padText(value, width, parser.AlignLeft)
+	},
+	"padLeft": func(width int, value string) string {
+		return padText(value, width, parser.AlignRight)
+	},
+	"center": func(width int, value string) string {
+		return padText(value, width, parser.AlignCenter)
+	},
+	"escape":  templateEscape,
+	"upper":   strings.ToUpper,
+	"lower":   strings.ToLower,
+	"trim":    strings.TrimSpace,
+	"replace": func(old, new, value string) string { return strings.ReplaceAll(value, old, new) },
+	"join":    func(sep string, values []string) string { return strings.Join(values, sep) },
+	"repeat":  func(count int, value string) string { return strings.Repeat(value, max(count, 0)) },
+	"add":     func(a, b int) int { return a + b },
+	"json":    templateJSON,
+}
+
+// templateEscapers are the formats the escape function knows
+var templateEscapers = map[string]func(string) string{
+	"html":       html.EscapeString,
+	"xml":        html.EscapeString,
+	"json":       jsonText,
+	"csv":        csvField,
+	"sql":        DialectPostgres.quoteString,
+	"url":        url.QueryEscape,
+	"markdown":   markdownEscape,
+	"latex":      latexEscape,
+	"rst":        rstEscape,
+	"asciidoc":   asciidocEscape,
+	"org":        orgText,
+	"jira":       jiraEscape,
+	"confluence": confluenceEscape,
+	"mediawiki":  mediawikiEscape,
+}
+
+func (r *TemplateRenderer) Render(data *parser.TableData) (string, error) {
+	if strings.TrimSpace(r.Template) == "" {
+		return "", fmt.Errorf("no template given")
+	}
+	name := r.Name
+	if name == "" {
+		name = "template"
+	}
+
+	type executor interface {
+		Execute(w io.Writer, data any) error
+	}
+	var t executor
+	var err error
+	if r.HTML {
+		t, err = htmltemplate.New(name).Funcs(templateFuncs).Parse(r.Template)
+	} else {
+		t, err = template.New(name).Funcs(templateFuncs).Parse(r.Template)
+	}
+	if err != nil {
+		return "", fmt.Errorf("invalid template: %v", err)
+	}
+
+	var result strings.Builder
+	if err := t.Execute(&result, TemplateTable{data}); err != nil {
+		return "", err
+	}
+	return result.String(), nil
+}
+
+// templateEscape escapes a value for one of the formats gotable writes
+func templateEscape(format, value string) (string, error) {
+	escape, ok := templateEscapers[strings.ToLower(format)]
+	if !ok {
+		return "", fmt.Errorf("unknown format %q", format)
+	}
+	return escape(value), nil
+}
+
+// templateJSON encodes a value as compact JSON
+func templateJSON(value any) (string, error) {
+	var b strings.Builder
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(value); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(b.String(), "\n"), nil
+}
+
+// jsonText escapes a value for use inside a JSON string
+func jsonText(value string) string {
+	quoted := quoteJSON(value)
+	return quoted[1 : len(quoted)-1]
+}
+
+// csvField quotes a value for a comma separated file if it needs it
+func csvField(value string) string {
+	var b strings.Builder
+	(&CSVRenderer{}).writeRecord(&b, []string{value})
+	return strings.TrimSuffix(b.String(), "\n")
+}
@@ -0,0 +1,80 @@
+package renderer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+func TestTemplateRenderer_Render(t *testing.T) {
+	data := &parser.TableData{
+		Name:    "stock",
+		Headers: []string{"name", "qty"},
+		Rows: []map[string]string{
+			{"name": "A&B", "qty": "5"},
+			{"name": "李", "qty": "12"},
+		},
+		Types: map[string]parser.ColumnType{"qty": parser.TypeInteger},
+	}
+
+	tests := []struct {
+		name     string
+		renderer *TemplateRenderer
+		want     string
+		wantErr  string
+	}{
+		{
+			name: "Text",
+			renderer: &TemplateRenderer{Template: `{{upper .Name}} {{$.Index "qty"}} {{$.ColumnType "qty"}}
+{{range $i, $row := .Rows}}{{add $i 1}}|{{pad ($.Width "name") (index $row "name")}}|{{padLeft 3 (index $row "qty")}}|{{escape "latex" (index $row "name")}}|{{join "," ($.Cells $row)}}|{{json $row}}
+{{end}}`},
+			want: "STOCK 1 integer\n" +
+				`1|A&B |  5|A\&B|A&B,5|{"name":"A&B","qty":"5"}` + "\n" +
+				`2|李  | 12|李|李,12|{"name":"李","qty":"12"}` + "\n",
+		},
+		{
+			name:     "HTML",
+			renderer: &TemplateRenderer{Template: `{{range .Rows}}<a title="{{index . "name"}}">{{index . "name"}}</a>{{end}}`, HTML: true},
+			want:     `<a title="A&amp;B">A&amp;B</a><a title="李">李</a>`,
+		},
+		{
+			name:     "Escape Formats",
+			renderer: &TemplateRenderer{Template: `{{escape "csv" "a,b"}} {{escape "json" "say \"hi\""}} {{escape "sql" "it's"}} {{escape "URL" "a b"}}`},
+			want:     `"a,b" say \"hi\" 'it''s' a+b`,
+		},
+		{
+			name:     "Unknown Escape",
+			renderer: &TemplateRenderer{Template: `{{escape "nope" "x"}}`},
+			wantErr:  `unknown format "nope"`,
+		},
+		{
+			name:     "Invalid Template",
+			renderer: &TemplateRenderer{Template: `{{range}}`, Name: "rows.tmpl"},
+			wantErr:  "invalid template: template: rows.tmpl",
+		},
+		{
+			name:     "No Template",
+			renderer: &TemplateRenderer{},
+			wantErr:  "no template given",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.renderer.Render(data)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("TemplateRenderer.Render() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TemplateRenderer.Render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("TemplateRenderer.Render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package renderer
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+// TOMLRenderer implements Renderer for TOML output, writing each row as an
+// entry of an array of tables. TOML has no null, so missing cells are left
+// out; nested values are written inline and dates and times as TOML date
+// and time literals.
+type TOMLRenderer struct {
+	// Table names the array of tables, "rows" by default
+	Table string
+	// Unflatten and Separator work as they do for JSONRenderer
+	Unflatten bool
+	Separator string
+}
+
+var bareKey = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+func (r *TOMLRenderer) Render(data *parser.TableData) (string, error) {
+	data = withFooter(data)
+	table := r.Table
+	if table == "" {
+		table = "rows"
+	}
+	records := &JSONRenderer{Unflatten: r.Unflatten, Separator: r.Separator, temporal: true}
+
+	var result strings.Builder
+	for i, row := range data.Rows {
+		if i > 0 {
+			result.WriteString("\n")
+		}
+		result.WriteString("[[" + tomlKey(table) + "]]\n")
+		obj, ok := records.record(data, row).(*jsonObject)
+		if !ok {
+			continue
+		}
+		for _, key := range obj.keys {
+			value := obj.values[key]
+			if value == jsonLiteral("null") {
+				continue
+			}
+			result.WriteString(tomlKey(key) + " = ")
+			writeTOML(&result, value)
+			result.WriteString("\n")
+		}
+	}
+	return result.String(), nil
+}
+
+func tomlKey(key string) string {
+	if bareKey.MatchString(key) {
+		return key
+	}
+	return quoteJSON(key)
+}
+
+// writeTOML writes a value inline. JSON string escapes are valid in TOML
+// basic strings, so encoded cells are written as they are.
+func writeTOML(b *strings.Builder, value any) {
+	switch v := value.(type) {
+	case *jsonObject:
+		b.WriteString("{")
+		first := true
+		for _, key := range v.keys {
+			if v.values[key] == jsonLiteral("null") {
+				continue
+			}
+			if !first {
+				b.WriteString(",")
+			}
+			first = false
+			b.WriteString(" " + tomlKey(key) + " = ")
+			writeTOML(b, v.values[key])
+		}
+		if !first {
+			b.WriteString(" ")
+		}
+		b.WriteString("}")
+	case []any:
+		b.WriteString("[")
+		for i, item := range v {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			if item == jsonLiteral("null") {
+				// Arrays cannot skip an entry, so a null keeps its place
+				item = jsonLiteral(`""`)
+			}
+			writeTOML(b, item)
+		}
+		b.WriteString("]")
+	case jsonLiteral:
+		b.WriteString(string(v))
+	case temporalLiteral:
+		b.WriteString(v.text)
+	}
+}
@@ -0,0 +1,102 @@
+package renderer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+func TestTOMLRenderer_Render(t *testing.T) {
+	data := &parser.TableData{
+		Headers: []string{"name", "score", "tags.0", "tags.1", "first name"},
+		Rows: []map[string]string{
+			{"name": "John \"J\"", "score": "9.5", "tags.0": "a", "tags.1": "b", "first name": "John"},
+			{"name": "Alice", "tags.0": "c"},
+		},
+		Types: map[string]parser.ColumnType{"score": parser.TypeFloat},
+	}
+
+	tests := []struct {
+		name     string
+		renderer *TOMLRenderer
+		want     string
+	}{
+		{
+			name:     "Array Of Tables",
+			renderer: &TOMLRenderer{},
+			want: `[[rows]]
+name = "John \"J\""
+score = 9.5
+"tags.0" = "a"
+"tags.1" = "b"
+"first name" = "John"
+
+[[rows]]
+name = "Alice"
+"tags.0" = "c"
+`,
+		},
+		{
+			name:     "Unflatten",
+			renderer: &TOMLRenderer{Table: "people", Unflatten: true},
+			want: `[[people]]
+name = "John \"J\""
+score = 9.5
+tags = ["a", "b"]
+"first name" = "John"
+
+[[people]]
+name = "Alice"
+tags = ["c"]
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.renderer.Render(data)
+			if err != nil {
+				t.Fatalf("TOMLRenderer.Render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("TOMLRenderer.Render() = %q, want %q", got, tt.want)
+			}
+			if _, err := (&parser.TOMLParser{}).Parse([]byte(got)); err != nil {
+				t.Errorf("TOMLParser.Parse() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestTOMLRenderer_DatesAndTimes(t *testing.T) {
+	input := `[[r]]
+offset = 1979-05-27T00:32:00.5-07:00
+local = 1979-05-27T07:32:00
+day = 2024-01-02
+at = 07:32:00.25
+n = 1
+`
+	data, err := (&parser.TOMLParser{}).Parse([]byte(input))
+	if err != nil {
+		t.Fatalf("TOMLParser.Parse() error = %v", err)
+	}
+
+	got, err := (&TOMLRenderer{Table: "r"}).Render(data)
+	if err != nil {
+		t.Fatalf("TOMLRenderer.Render() error = %v", err)
+	}
+	if got != input {
+		t.Errorf("TOMLRenderer.Render() = %q, want %q", got, input)
+	}
+
+	// A value that is no valid date stays a string
+	data.Rows[0]["day"] = "soon"
+	got, err = (&TOMLRenderer{Table: "r"}).Render(data)
+	if err != nil {
+		t.Fatalf("TOMLRenderer.Render() error = %v", err)
+	}
+	if !strings.Contains(got, `day = "soon"`) {
+		t.Errorf("TOMLRenderer.Render() = %q, want day as a string", got)
+	}
+}
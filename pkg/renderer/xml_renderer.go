@@ -0,0 +1,297 @@
+package renderer
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+// XMLRenderer implements Renderer for XML output. Columns named @name become
+// attributes and dotted column names become nested elements, mirroring what
+// XMLParser produces. Prefixed names such as dc:title are declared with the
+// namespace the table records, and names that clash once made valid are
+// numbered. Text for an element that other columns nest in is written from
+// a name.#text column, which is how XMLParser reads it back.
+type XMLRenderer struct {
+	// Root and Record name the document element and the per-row element
+	Root   string
+	Record string
+	// Attributes lists columns written as attributes of the record element
+	// instead of child elements
+	Attributes []string
+}
+
+// xmlNode is an element of a record being assembled
+type xmlNode struct {
+	name     string
+	attrs    [][2]string
+	text     string
+	children []*xmlNode
+	// column names the column that wrote text as a leaf element
+	column string
+}
+
+func (n *xmlNode) child(name string) *xmlNode {
+	for _, c := range n.children {
+		if c.name == name {
+			return c
+		}
+	}
+	c := &xmlNode{name: name}
+	n.children = append(n.children, c)
+	return c
+}
+
+func (r *XMLRenderer) Render(data *parser.TableData) (string, error) {
+	data = withFooter(data)
+	root := xmlName(r.Root, "rows")
+	record := xmlName(r.Record, "row")
+
+	attributes := make(map[string]bool)
+	for _, a := range r.Attributes {
+		attributes[a] = true
+	}
+
+	// Work out where each column goes once, keeping the names unique
+	columns := make(map[string]xmlColumn)
+	used := make(map[string]bool)
+	prefixes := []string{xmlPrefix(root), xmlPrefix(record)}
+	for _, h := range data.Headers {
+		col := newXMLColumn(h, attributes[h])
+		if used[col.key()] && col.name == "" {
+			return "", fmt.Errorf("column %q holds the same text as another column", h)
+		}
+		for n, base := 2, col.name; used[col.key()]; n++ {
+			col.name = fmt.Sprintf("%s_%d", base, n)
+		}
+		used[col.key()] = true
+		columns[h] = col
+		for _, parent := range col.parents {
+			prefixes = append(prefixes, xmlPrefix(parent))
+		}
+		prefixes = append(prefixes, xmlPrefix(col.name))
+	}
+
+	var result strings.Builder
+	result.WriteString(xml.Header)
+	result.WriteString("<" + root)
+	declared := map[string]bool{"": true, "xml": true}
+	for _, prefix := range prefixes {
+		if declared[prefix] {
+			continue
+		}
+		declared[prefix] = true
+		uri, ok := data.Namespaces[prefix]
+		if !ok {
+			uri = "urn:x-prefix:" + prefix
+		}
+		result.WriteString(" xmlns:" + prefix + `="`)
+		xml.EscapeText(&result, []byte(uri))
+		result.WriteString(`"`)
+	}
+	result.WriteString(">\n")
+
+	for n, row := range data.Rows {
+		node := &xmlNode{name: record}
+		for _, h := range data.Headers {
+			if value, ok := row[h]; ok {
+				columns[h].place(node, h, value)
+			}
+		}
+		// XMLParser reads the text of an element with children as
+		// name.#text, so a leaf column cannot also hold nested ones
+		if h := node.mixedColumn(); h != "" {
+			return "", fmt.Errorf("row %d: column %q holds text for an element other columns nest in; name it %q instead", n+1, h, h+".#text")
+		}
+		writeXMLNode(&result, node, 1)
+	}
+
+	result.WriteString("</" + root + ">\n")
+	return result.String(), nil
+}
+
+// xmlColumn is where a column is written in a record: the elements leading
+// to it, then an attribute, a child element or, with no name, their text
+type xmlColumn struct {
+	parents []string
+	name    string
+	attr    bool
+}
+
+// newXMLColumn maps a header to its place in the record. A header such as
+// price.@currency is split into a path only when every part is a valid name.
+func newXMLColumn(header string, attr bool) xmlColumn {
+	if attr {
+		return xmlColumn{name: xmlName(strings.TrimPrefix(header, "@"), "_"), attr: true}
+	}
+
+	parts := strings.Split(header, ".")
+	for i, part := range parts {
+		if name := strings.TrimPrefix(part, "@"); (part != "#text" || i < len(parts)-1) && !validXMLName(name) {
+			parts = []string{header}
+			break
+		}
+	}
+
+	col := xmlColumn{parents: parts[:len(parts)-1]}
+	for i, part := range col.parents {
+		col.parents[i] = strings.TrimPrefix(part, "@")
+	}
+	switch last := parts[len(parts)-1]; {
+	case last == "#text":
+	case strings.HasPrefix(last, "@"):
+		col.name, col.attr = xmlName(strings.TrimPrefix(last, "@"), "_"), true
+	default:
+		col.name = xmlName(last, "_")
+	}
+	return col
+}
+
+// key identifies the value a column writes, so two columns writing the same
+// one can be told apart
+func (c xmlColumn) key() string {
+	path := append([]string{""}, c.parents...)
+	switch {
+	case c.attr:
+		path = append(path, "@"+c.name)
+	case c.name != "":
+		path = append(path, c.name, "#text")
+	default:
+		path = append(path, "#text")
+	}
+	return strings.Join(path, "/")
+}
+
+// place stores the cell of column header in the record tree
+func (c xmlColumn) place(node *xmlNode, header, value string) {
+	for _, parent := range c.parents {
+		node = node.child(parent)
+	}
+	switch {
+	case c.attr:
+		node.attrs = append(node.attrs, [2]string{c.name, value})
+	case c.name != "":
+		leaf := node.child(c.name)
+		leaf.text = value
+		if value != "" {
+			leaf.column = header
+		}
+	default:
+		node.text = value
+	}
+}
+
+// mixedColumn returns the column that wrote text as a leaf element into an
+// element that also has children, if there is one
+func (n *xmlNode) mixedColumn() string {
+	if n.column != "" && len(n.children) > 0 {
+		return n.column
+	}
+	for _, c := range n.children {
+		if h := c.mixedColumn(); h != "" {
+			return h
+		}
+	}
+	return ""
+}
+
+func writeXMLNode(result *strings.Builder, node *xmlNode, depth int) {
+	indent := strings.Repeat("  ", depth)
+	result.WriteString(indent + "<" + node.name)
+	for _, attr := range node.attrs {
+		result.WriteString(" " + attr[0] + `="`)
+		xml.EscapeText(result, []byte(attr[1]))
+		result.WriteString(`"`)
+	}
+
+	if len(node.children) == 0 {
+		if node.text == "" {
+			result.WriteString("/>\n")
+			return
+		}
+		result.WriteString(">")
+		xml.EscapeText(result, []byte(node.text))
+		result.WriteString("</" + node.name + ">\n")
+		return
+	}
+
+	result.WriteString(">")
+	xml.EscapeText(result, []byte(node.text))
+	result.WriteString("\n")
+	for _, child := range node.children {
+		writeXMLNode(result, child, depth+1)
+	}
+	result.WriteString(indent + "</" + node.name + ">\n")
+}
+
+// xmlName turns a header into a valid XML name, replacing invalid characters
+// with underscores. A namespace prefix, as in dc:title, is kept when both
+// halves are valid names.
+func xmlName(name, fallback string) string {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fallback
+	}
+	if prefix, local, ok := strings.Cut(name, ":"); ok && isNCName(prefix) && isNCName(local) {
+		// Prefixes starting with "xml" are reserved, except xml itself
+		if prefix == "xml" || !strings.HasPrefix(strings.ToLower(prefix), "xml") {
+			return name
+		}
+	}
+
+	var b strings.Builder
+	for i, r := range name {
+		switch {
+		case isNameStart(r):
+			b.WriteRune(r)
+		case i > 0 && isNameChar(r):
+			b.WriteRune(r)
+		case i == 0 && isNameChar(r):
+			b.WriteString("_")
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+
+	result := b.String()
+	// Names starting with "xml" are reserved
+	if strings.HasPrefix(strings.ToLower(result), "xml") {
+		result = "_" + result
+	}
+	return result
+}
+
+// xmlPrefix returns the namespace prefix of a name, if it has one
+func xmlPrefix(name string) string {
+	prefix, _, _ := strings.Cut(name, ":")
+	if prefix == name {
+		return ""
+	}
+	return prefix
+}
+
+// isNCName reports whether a name is valid without a namespace prefix
+func isNCName(name string) bool {
+	for i, r := range name {
+		if !isNameStart(r) && (i == 0 || !isNameChar(r)) {
+			return false
+		}
+	}
+	return name != ""
+}
+
+func validXMLName(name string) bool {
+	return name != "" && xmlName(name, "") == name
+}
+
+func isNameStart(r rune) bool {
+	return r == '_' || unicode.IsLetter(r)
+}
+
+func isNameChar(r rune) bool {
+	return isNameStart(r) || r == '-' || r == '.' || unicode.IsDigit(r)
+}
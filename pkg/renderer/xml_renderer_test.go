@@ -0,0 +1,148 @@
+package renderer
+
+import (
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+func TestXMLRenderer_Render(t *testing.T) {
+	data := &parser.TableData{
+		Headers: []string{"Name", "Unit Price", "2nd", "Notes"},
+		Rows: []map[string]string{
+			{"Name": "Tea & Cake", "Unit Price": "3", "2nd": "<none>", "Notes": `say "hi"`},
+		},
+	}
+
+	renderer := &XMLRenderer{Root: "menu", Record: "dish", Attributes: []string{"Notes"}}
+	got, err := renderer.Render(data)
+	if err != nil {
+		t.Fatalf("XMLRenderer.Render() error = %v", err)
+	}
+
+	checks := []string{
+		"<menu>",
+		`<dish Notes="say &#34;hi&#34;">`,
+		"<Name>Tea &amp; Cake</Name>",
+		"<Unit_Price>3</Unit_Price>",
+		"<_2nd>&lt;none&gt;</_2nd>",
+		"</menu>",
+	}
+	for _, check := range checks {
+		if !strings.Contains(got, check) {
+			t.Errorf("XMLRenderer.Render() output doesn't contain %q:\n%s", check, got)
+		}
+	}
+}
+
+func TestXMLRenderer_RoundTrip(t *testing.T) {
+	data := &parser.TableData{
+		Headers: []string{"@id", "title", "price.@currency", "price", "author.name"},
+		Rows: []map[string]string{
+			{"@id": "1", "title": "Go & XML", "price.@currency": "USD", "price": "30", "author.name": "Alan"},
+			{"@id": "2", "title": "", "price.@currency": "EUR", "price": "25", "author.name": "Ada"},
+		},
+	}
+
+	output, err := (&XMLRenderer{Root: "catalog", Record: "book"}).Render(data)
+	if err != nil {
+		t.Fatalf("XMLRenderer.Render() error = %v", err)
+	}
+
+	got, err := (&parser.XMLParser{RecordPath: "/catalog/book"}).Parse([]byte(output))
+	if err != nil {
+		t.Fatalf("XMLParser.Parse() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, data) {
+		t.Errorf("Round trip = %v, want %v\n%s", got, data, output)
+	}
+}
+
+func TestXMLRenderer_Namespaces(t *testing.T) {
+	input := `<feed xmlns:dc="http://purl.org/dc/elements/1.1/"><entry><title>A</title><dc:title>B</dc:title></entry></feed>`
+	data, err := (&parser.XMLParser{RecordPath: "/feed/entry"}).Parse([]byte(input))
+	if err != nil {
+		t.Fatalf("XMLParser.Parse() error = %v", err)
+	}
+
+	output, err := (&XMLRenderer{Root: "feed", Record: "entry"}).Render(data)
+	if err != nil {
+		t.Fatalf("XMLRenderer.Render() error = %v", err)
+	}
+	if !strings.Contains(output, `<feed xmlns:dc="http://purl.org/dc/elements/1.1/">`) || !strings.Contains(output, "<dc:title>B</dc:title>") {
+		t.Errorf("XMLRenderer.Render() lost the namespace:\n%s", output)
+	}
+
+	got, err := (&parser.XMLParser{RecordPath: "/feed/entry"}).Parse([]byte(output))
+	if err != nil {
+		t.Fatalf("XMLParser.Parse() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, data) {
+		t.Errorf("Round trip = %v, want %v\n%s", got, data, output)
+	}
+
+	// A prefix the table has no URI for is still declared
+	output, err = (&XMLRenderer{}).Render(&parser.TableData{
+		Headers: []string{"ex:id"},
+		Rows:    []map[string]string{{"ex:id": "1"}},
+	})
+	if err != nil {
+		t.Fatalf("XMLRenderer.Render() error = %v", err)
+	}
+	if err := xml.Unmarshal([]byte(output), new(struct{})); err != nil || !strings.Contains(output, `xmlns:ex=`) {
+		t.Errorf("XMLRenderer.Render() did not declare the prefix (%v):\n%s", err, output)
+	}
+}
+
+func TestXMLRenderer_NameClashes(t *testing.T) {
+	data := &parser.TableData{
+		Headers: []string{"a b", "a_b", "a?b", "@c d", "@c_d"},
+		Rows: []map[string]string{
+			{"a b": "1", "a_b": "2", "a?b": "3", "@c d": "4", "@c_d": "5"},
+		},
+	}
+
+	output, err := (&XMLRenderer{}).Render(data)
+	if err != nil {
+		t.Fatalf("XMLRenderer.Render() error = %v", err)
+	}
+	for _, check := range []string{`<row c_d="4" c_d_2="5">`, "<a_b>1</a_b>", "<a_b_2>2</a_b_2>", "<a_b_3>3</a_b_3>"} {
+		if !strings.Contains(output, check) {
+			t.Errorf("XMLRenderer.Render() output doesn't contain %q:\n%s", check, output)
+		}
+	}
+
+	// Two columns holding the text of the same element cannot be told apart
+	_, err = (&XMLRenderer{}).Render(&parser.TableData{Headers: []string{"p", "p.#text"}})
+	if err == nil {
+		t.Error("XMLRenderer.Render() expected an error for columns sharing an element's text")
+	}
+}
+
+func TestXMLRenderer_NestedUnderLeaf(t *testing.T) {
+	// Columns p and p.q round trip as long as no row has both
+	data := &parser.TableData{
+		Headers: []string{"p", "p.q"},
+		Rows:    []map[string]string{{"p": "text"}, {"p.q": "1"}},
+	}
+	output, err := (&XMLRenderer{}).Render(data)
+	if err != nil {
+		t.Fatalf("XMLRenderer.Render() error = %v", err)
+	}
+	got, err := (&parser.XMLParser{RecordPath: "/rows/row"}).Parse([]byte(output))
+	if err != nil {
+		t.Fatalf("XMLParser.Parse() error = %v", err)
+	}
+	if !reflect.DeepEqual(got.Rows, data.Rows) {
+		t.Errorf("Round trip = %v, want %v\n%s", got.Rows, data.Rows, output)
+	}
+
+	// A row with both would come back as p.#text
+	data.Rows = append(data.Rows, map[string]string{"p": "both", "p.q": "2"})
+	if _, err := (&XMLRenderer{}).Render(data); err == nil || !strings.Contains(err.Error(), `"p.#text"`) {
+		t.Errorf("XMLRenderer.Render() error = %v, want one naming p.#text", err)
+	}
+}
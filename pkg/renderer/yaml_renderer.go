@@ -0,0 +1,91 @@
+package renderer
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+	"gopkg.in/yaml.v3"
+)
+
+// YAMLRenderer implements Renderer for YAML output: a sequence with one
+// mapping per row, keys in header order. Typed columns are written as YAML
+// numbers, booleans and timestamps and missing cells as null.
+type YAMLRenderer struct {
+	// Unflatten and Separator work as they do for JSONRenderer
+	Unflatten bool
+	Separator string
+}
+
+func (r *YAMLRenderer) Render(data *parser.TableData) (string, error) {
+	data = withFooter(data)
+	records := &JSONRenderer{Unflatten: r.Unflatten, Separator: r.Separator, temporal: true}
+	root := &yaml.Node{Kind: yaml.SequenceNode}
+	for _, row := range data.Rows {
+		root.Content = append(root.Content, yamlNode(records.record(data, row)))
+	}
+
+	var result strings.Builder
+	enc := yaml.NewEncoder(&result)
+	enc.SetIndent(2)
+	if err := enc.Encode(root); err != nil {
+		return "", err
+	}
+	if err := enc.Close(); err != nil {
+		return "", err
+	}
+	return result.String(), nil
+}
+
+var yaml11Bools = map[string]bool{
+	"y": true, "n": true, "yes": true, "no": true, "on": true, "off": true,
+}
+
+// yamlNode converts a record built by JSONRenderer to a YAML node, tagging
+// scalars so strings that look like numbers stay strings
+func yamlNode(value any) *yaml.Node {
+	switch v := value.(type) {
+	case *jsonObject:
+		node := &yaml.Node{Kind: yaml.MappingNode}
+		for _, key := range v.keys {
+			node.Content = append(node.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key},
+				yamlNode(v.values[key]))
+		}
+		return node
+	case []any:
+		node := &yaml.Node{Kind: yaml.SequenceNode}
+		for _, item := range v {
+			node.Content = append(node.Content, yamlNode(item))
+		}
+		return node
+	case temporalLiteral:
+		// YAML has dates and timestamps but no times of day
+		if v.kind == parser.TypeTime {
+			return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: v.text}
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!timestamp", Value: v.text}
+	case jsonLiteral:
+		literal := string(v)
+		switch {
+		case literal == "null":
+			return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}
+		case literal == "true" || literal == "false":
+			return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: literal}
+		case strings.HasPrefix(literal, `"`):
+			var s string
+			json.Unmarshal([]byte(literal), &s)
+			node := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: s}
+			if yaml11Bools[strings.ToLower(s)] {
+				// YAML 1.1 readers would take these for booleans
+				node.Style = yaml.DoubleQuotedStyle
+			}
+			return node
+		case strings.ContainsAny(literal, ".eE"):
+			return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!float", Value: literal}
+		default:
+			return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: literal}
+		}
+	}
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}
+}
@@ -0,0 +1,93 @@
+package renderer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gowtham2003/gotable/pkg/parser"
+)
+
+func TestYAMLRenderer_Render(t *testing.T) {
+	data := &parser.TableData{
+		Headers: []string{"name", "age", "address.city"},
+		Rows: []map[string]string{
+			{"name": "John", "age": "30", "address.city": "Oslo"},
+			{"name": "007", "address.city": "yes"},
+		},
+		Types: map[string]parser.ColumnType{"age": parser.TypeInteger},
+	}
+
+	tests := []struct {
+		name     string
+		renderer *YAMLRenderer
+		want     string
+	}{
+		{
+			name:     "Records",
+			renderer: &YAMLRenderer{},
+			want: `- name: John
+  age: 30
+  address.city: Oslo
+- name: "007"
+  age: null
+  address.city: "yes"
+`,
+		},
+		{
+			name:     "Unflatten",
+			renderer: &YAMLRenderer{Unflatten: true},
+			want: `- name: John
+  age: 30
+  address:
+    city: Oslo
+- name: "007"
+  address:
+    city: "yes"
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.renderer.Render(data)
+			if err != nil {
+				t.Fatalf("YAMLRenderer.Render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("YAMLRenderer.Render() = %q, want %q", got, tt.want)
+			}
+
+			// The output reads back as the same table
+			back, err := (&parser.YAMLParser{}).Parse([]byte(got))
+			if err != nil {
+				t.Fatalf("YAMLParser.Parse() error = %v", err)
+			}
+			if back.Rows[1]["name"] != "007" || back.ColumnType("age") != parser.TypeInteger {
+				t.Errorf("YAML round trip = %v", back.Rows)
+			}
+		})
+	}
+}
+
+func TestYAMLRenderer_Timestamps(t *testing.T) {
+	input := `- day: 2024-01-02
+  at: 2024-01-02T10:30:00Z
+  n: 1
+`
+	data, err := (&parser.YAMLParser{}).Parse([]byte(input))
+	if err != nil {
+		t.Fatalf("YAMLParser.Parse() error = %v", err)
+	}
+	wantTypes := map[string]parser.ColumnType{"day": parser.TypeDate, "at": parser.TypeTimestamp, "n": parser.TypeInteger}
+	if !reflect.DeepEqual(data.Types, wantTypes) {
+		t.Errorf("YAMLParser.Parse() types = %v, want %v", data.Types, wantTypes)
+	}
+
+	got, err := (&YAMLRenderer{}).Render(data)
+	if err != nil {
+		t.Fatalf("YAMLRenderer.Render() error = %v", err)
+	}
+	if got != input {
+		t.Errorf("YAMLRenderer.Render() = %q, want %q", got, input)
+	}
+}
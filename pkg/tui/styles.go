@@ -27,6 +27,9 @@ var (
 				Padding(0, 3).
 				MarginRight(2)
 
+	warningStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#FFB454"))
+
 	errorStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("#FF0000")).
 			Bold(true).
@@ -45,6 +45,7 @@ type model struct {
 	outputFile       string
 	style            string
 	preview          string
+	warnings         []parser.Warning
 	err              error
 	width            int
 	height           int
@@ -64,9 +65,21 @@ var (
 
 	inputFormats = []list.Item{
 		item{title: "JSON", desc: "JavaScript Object Notation"},
+		item{title: "JSONL", desc: "JSON Lines, one record per line"},
 		item{title: "CSV", desc: "Comma Separated Values"},
+		item{title: "TSV", desc: "Tab Separated Values"},
 		item{title: "Excel", desc: "Microsoft Excel Spreadsheet"},
 		item{title: "HTML", desc: "HTML Table Format"},
+		item{title: "Markdown", desc: "Markdown Table Format"},
+		item{title: "XML", desc: "Extensible Markup Language"},
+		item{title: "ASCII", desc: "Text tables from psql, mysql or box drawing"},
+		item{title: "Fixed", desc: "Fixed-width columns"},
+		item{title: "YAML", desc: "YAML Ain't Markup Language"},
+		item{title: "TOML", desc: "Tom's Obvious Minimal Language"},
+		item{title: "SQLite", desc: "SQLite database, first table"},
+		item{title: "SQL", desc: "INSERT statements from a SQL dump"},
+		item{title: "Parquet", desc: "Apache Parquet columnar file"},
+		item{title: "Arrow", desc: "Apache Arrow IPC file or stream, Feather"},
 	}
 
 	outputFormats = []list.Item{
@@ -74,9 +87,26 @@ var (
 		item{title: "HTML", desc: "HTML Table Format"},
 		item{title: "Excel", desc: "Microsoft Excel Spreadsheet"},
 		item{title: "CSV", desc: "Comma Separated Values"},
+		item{title: "TSV", desc: "Tab Separated Values"},
 		item{title: "JSON", desc: "JavaScript Object Notation"},
+		item{title: "JSONL", desc: "JSON Lines, one record per line"},
 		item{title: "Markdown", desc: "Markdown Table Format"},
 		item{title: "PNG", desc: "PNG Image Format"},
+		item{title: "XML", desc: "Extensible Markup Language"},
+		item{title: "Fixed", desc: "Fixed-width columns"},
+		item{title: "YAML", desc: "YAML Ain't Markup Language"},
+		item{title: "TOML", desc: "Tom's Obvious Minimal Language"},
+		item{title: "SQLite", desc: "SQLite database"},
+		item{title: "SQL", desc: "CREATE TABLE and INSERT script"},
+		item{title: "Parquet", desc: "Apache Parquet columnar file"},
+		item{title: "Arrow", desc: "Apache Arrow IPC file, Feather"},
+		item{title: "LaTeX", desc: "LaTeX tabular environment"},
+		item{title: "RST", desc: "reStructuredText grid table"},
+		item{title: "AsciiDoc", desc: "AsciiDoc table"},
+		item{title: "Org", desc: "Org mode table"},
+		item{title: "Jira", desc: "Jira and Confluence wiki markup"},
+		item{title: "Confluence", desc: "Confluence storage format XHTML"},
+		item{title: "MediaWiki", desc: "MediaWiki wikitable"},
 	}
 
 	styleOptions = []list.Item{
@@ -97,8 +127,9 @@ func (i item) FilterValue() string { return i.title }
 
 // Add new message types
 type conversionFinishedMsg struct {
-	output string
-	err    error
+	output   string
+	warnings []parser.Warning
+	err      error
 }
 
 type processingMsg float64
@@ -136,10 +167,75 @@ var formatCapabilities = map[string]FormatCapabilities{
 		SupportsPreview: true,
 		FileExtension:   "csv",
 	},
+	"TSV": {
+		SupportsPreview: true,
+		FileExtension:   "tsv",
+	},
 	"JSON": {
 		SupportsPreview: true,
 		FileExtension:   "json",
 	},
+	"JSONL": {
+		SupportsPreview: true,
+		FileExtension:   "jsonl",
+	},
+	"XML": {
+		SupportsPreview: true,
+		FileExtension:   "xml",
+	},
+	"Fixed": {
+		SupportsPreview: true,
+		FileExtension:   "txt",
+	},
+	"YAML": {
+		SupportsPreview: true,
+		FileExtension:   "yaml",
+	},
+	"TOML": {
+		SupportsPreview: true,
+		FileExtension:   "toml",
+	},
+	"SQLite": {
+		FileExtension: "db",
+	},
+	"SQL": {
+		SupportsPreview: true,
+		FileExtension:   "sql",
+	},
+	"Parquet": {
+		FileExtension: "parquet",
+	},
+	"Arrow": {
+		FileExtension: "arrow",
+	},
+	"LaTeX": {
+		SupportsPreview: true,
+		FileExtension:   "tex",
+	},
+	"RST": {
+		SupportsPreview: true,
+		FileExtension:   "rst",
+	},
+	"AsciiDoc": {
+		SupportsPreview: true,
+		FileExtension:   "adoc",
+	},
+	"Org": {
+		SupportsPreview: true,
+		FileExtension:   "org",
+	},
+	"Jira": {
+		SupportsPreview: true,
+		FileExtension:   "txt",
+	},
+	"Confluence": {
+		SupportsPreview: true,
+		FileExtension:   "xhtml",
+	},
+	"MediaWiki": {
+		SupportsPreview: true,
+		FileExtension:   "wiki",
+	},
 	"Excel": {
 		SupportsColors: true,
 		SupportsFonts:  true,
@@ -208,7 +304,7 @@ func (m model) processConversion() tea.Cmd {
 			return conversionFinishedMsg{err: fmt.Errorf("error rendering output: %v", err)}
 		}
 
-		return conversionFinishedMsg{output: output}
+		return conversionFinishedMsg{output: output, warnings: data.Warnings}
 	}
 }
 
@@ -285,6 +381,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 		m.preview = msg.output
+		m.warnings = msg.warnings
 		m.state = statePreview
 		m.viewport.SetContent(msg.output)
 		return m, nil
@@ -446,6 +543,7 @@ func (m model) View() string {
 		s.WriteString(titleStyle.Render("Preview"))
 		s.WriteString("\n\n")
 		s.WriteString(m.viewport.View())
+		s.WriteString(m.warningsView())
 		s.WriteString("\n\nSave this output? (y/n)")
 
 	case stateDone:
@@ -455,6 +553,7 @@ func (m model) View() string {
 		} else {
 			s.WriteString(successStyle.Render("\n\nConversion completed successfully!"))
 			s.WriteString(fmt.Sprintf("\n\nOutput saved to: %s", m.outputFile))
+			s.WriteString(m.warningsView())
 			s.WriteString("\n\nPress q to quit")
 		}
 	}
@@ -465,6 +564,25 @@ func (m model) View() string {
 	return s.String()
 }
 
+// warningsView lists the problems found in the input, if any
+func (m model) warningsView() string {
+	if len(m.warnings) == 0 {
+		return ""
+	}
+
+	const limit = 5
+	var view strings.Builder
+	view.WriteString(fmt.Sprintf("\n\n%d problems in the input were repaired:", len(m.warnings)))
+	for i, w := range m.warnings {
+		if i == limit {
+			view.WriteString(fmt.Sprintf("\n  ... and %d more", len(m.warnings)-limit))
+			break
+		}
+		view.WriteString("\n  " + w.String())
+	}
+	return warningStyle.Render(view.String())
+}
+
 func (m model) helpView() string {
 	var help strings.Builder
 	help.WriteString("\n\n")
@@ -16,13 +16,11 @@ import (
 // Test data structures
 var (
 	simpleData = `[
-		{"name": "Name", "age": "Age", "city": "City"},
 		{"name": "John", "age": "30", "city": "New York"},
 		{"name": "Alice", "age": "25", "city": "London"}
 	]`
 
 	complexData = `[
-		{"id": "ID", "name": "Name", "age": "Age", "city": "City", "salary": "Salary", "department": "Department"},
 		{"id": "1", "name": "John Doe", "age": "30", "city": "New York", "salary": "75000", "department": "Engineering"},
 		{"id": "2", "name": "Alice Smith", "age": "25", "city": "London", "salary": "65000", "department": "Marketing"},
 		{"id": "3", "name": "Bob Johnson", "age": "35", "city": "Paris", "salary": "80000", "department": "Engineering"},
@@ -336,7 +334,7 @@ func TestEndToEndErrors(t *testing.T) {
 			name:         "Invalid CSV Input",
 			inputFormat:  "csv",
 			outputFormat: "json",
-			inputData:    "invalid,csv,format\ninvalid",
+			inputData:    "name,age\n\"John,30",
 			expectError:  true,
 		},
 		{
@@ -380,3 +378,62 @@ func TestEndToEndErrors(t *testing.T) {
 		})
 	}
 }
+
+// TestEndToEndFooter converts a table with a totals row to every output
+// format and checks the footer is not lost
+func TestEndToEndFooter(t *testing.T) {
+	input := []byte(`<table>
+		<thead><tr><th>Item</th><th>Amount</th></tr></thead>
+		<tbody><tr><td>Apples</td><td>3</td></tr><tr><td>Pears</td><td>4</td></tr></tbody>
+		<tfoot><tr><td>Total</td><td>7</td></tr></tfoot>
+	</table>`)
+
+	data, err := (&parser.HTMLParser{}).Parse(input)
+	if err != nil {
+		t.Fatalf("Failed to parse input: %v", err)
+	}
+	if len(data.Footer) != 1 {
+		t.Fatalf("Expected 1 footer row, got %d", len(data.Footer))
+	}
+
+	for _, format := range []string{"ascii", "csv", "tsv", "json", "jsonl", "markdown", "html", "xml", "fixed", "yaml", "toml", "sql", "latex", "rst", "asciidoc", "org", "jira", "confluence", "mediawiki"} {
+		t.Run(format, func(t *testing.T) {
+			r, err := renderer.NewRenderer(format)
+			if err != nil {
+				t.Fatalf("Failed to create renderer: %v", err)
+			}
+			output, err := r.Render(data)
+			if err != nil {
+				t.Fatalf("Failed to render output: %v", err)
+			}
+			if !strings.Contains(output, "Total") || !strings.Contains(output, "7") {
+				t.Errorf("Footer row missing from %s output:\n%s", format, output)
+			}
+		})
+	}
+
+	// Binary formats are read back to find the footer row
+	for _, format := range []string{"xlsx", "parquet", "arrow"} {
+		t.Run(format, func(t *testing.T) {
+			r, err := renderer.NewRenderer(format)
+			if err != nil {
+				t.Fatalf("Failed to create renderer: %v", err)
+			}
+			output, err := r.Render(data)
+			if err != nil {
+				t.Fatalf("Failed to render output: %v", err)
+			}
+			p, err := parser.NewParser(format)
+			if err != nil {
+				t.Fatalf("Failed to create parser: %v", err)
+			}
+			result, err := p.Parse([]byte(output))
+			if err != nil {
+				t.Fatalf("Failed to parse output: %v", err)
+			}
+			if n := len(result.Rows); n != 3 || result.Rows[n-1]["Item"] != "Total" || result.Rows[n-1]["Amount"] != "7" {
+				t.Errorf("Footer row missing from %s output: %v", format, result.Rows)
+			}
+		})
+	}
+}